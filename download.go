@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultDownloadMaxBytes caps the size of a downloaded attachment
+const defaultDownloadMaxBytes = 25 << 20
+
+// inlineMaxBytes caps the size of an attachment returned inline instead of
+// being written to disk
+const inlineMaxBytes = 1 << 20
+
+// attachmentHost is the only host attachments are downloaded from, since
+// the Linear API key is sent along with the request
+const attachmentHost = "uploads.linear.app"
+
+// downloader fetches Linear attachments, writing them only inside root
+type downloader struct {
+	root     string // Absolute download directory with symlinks resolved
+	maxBytes int64
+	apiKey   string
+	httpCli  *http.Client
+}
+
+// newDownloader creates a downloader confined to root
+func newDownloader(root string, maxBytes int64, apiKey string, httpCli *http.Client) (*downloader, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(abs, 0o755); err != nil {
+		return nil, err
+	}
+
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &downloader{root: resolved, maxBytes: maxBytes, apiKey: apiKey, httpCli: httpCli}, nil
+}
+
+// download is an attachment fetched into memory or onto disk
+type download struct {
+	Path     string // Where the attachment was written, empty when fetched inline
+	Size     int64
+	MIMEType string
+	Data     []byte // The attachment contents when fetched inline
+}
+
+// open starts downloading an attachment, rejecting URLs outside Linear's
+// upload storage and responses that announce more than limit bytes
+func (d *downloader) open(ctx context.Context, rawURL string, limit int64) (*http.Response, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Host != attachmentHost {
+		return nil, fmt.Errorf("invalid URL: must be an https URL on %s", attachmentHost)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", d.apiKey)
+
+	resp, err := d.httpCli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download attachment: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download attachment: server returned status %d", resp.StatusCode)
+	}
+
+	if resp.ContentLength > limit {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download attachment: it is %d bytes, more than the limit of %d", resp.ContentLength, limit)
+	}
+
+	return resp, nil
+}
+
+// fetchInline downloads a small attachment into memory
+func (d *downloader) fetchInline(ctx context.Context, rawURL string) (*download, error) {
+	limit := min(d.maxBytes, inlineMaxBytes)
+
+	resp, err := d.open(ctx, rawURL, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download attachment: %w", err)
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("failed to download attachment: it is larger than the inline limit of %d bytes; pass file_path to save it instead", limit)
+	}
+
+	return &download{
+		Size:     int64(len(data)),
+		MIMEType: detectMIMEType(data, resp.Header.Get("Content-Type")),
+		Data:     data,
+	}, nil
+}
+
+// fetchToFile downloads an attachment to path, which must lie inside the
+// download root. The file is written under a temporary name and renamed into
+// place once complete, so a failed download never leaves a partial file.
+func (d *downloader) fetchToFile(ctx context.Context, rawURL, path string, overwrite bool) (*download, error) {
+	target, err := d.resolvePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !overwrite {
+		if _, err := os.Lstat(target); err == nil {
+			return nil, fmt.Errorf("%s already exists; pass overwrite to replace it", path)
+		}
+	}
+
+	resp, err := d.open(ctx, rawURL, d.maxBytes)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+
+	sniffer := &headWriter{w: tmp}
+	size, err := io.Copy(sniffer, io.LimitReader(resp.Body, d.maxBytes+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write attachment to file: %w", err)
+	}
+	if size > d.maxBytes {
+		return nil, fmt.Errorf("failed to download attachment: it is larger than the limit of %d bytes", d.maxBytes)
+	}
+
+	if !overwrite {
+		if _, err := os.Lstat(target); err == nil {
+			return nil, fmt.Errorf("%s already exists; pass overwrite to replace it", path)
+		}
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return nil, fmt.Errorf("failed to write attachment to file: %w", err)
+	}
+
+	return &download{
+		Path:     target,
+		Size:     size,
+		MIMEType: detectMIMEType(sniffer.head, resp.Header.Get("Content-Type")),
+	}, nil
+}
+
+// resolvePath maps path to an absolute file path inside the download root.
+// Relative paths are taken relative to the root, and missing directories
+// inside the root are created.
+func (d *downloader) resolvePath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("file_path is required unless inline is set")
+	}
+
+	full := path
+	if !filepath.IsAbs(full) {
+		full = filepath.Join(d.root, full)
+	}
+	full = filepath.Clean(full)
+
+	name := filepath.Base(full)
+	if name == "." || name == string(filepath.Separator) || full == d.root {
+		return "", fmt.Errorf("file_path must name a file inside %s", d.root)
+	}
+
+	// Check the path as written, then again once symlinks in the part that
+	// already exists are resolved, before creating any missing directories
+	dir := filepath.Dir(full)
+	if !within(d.root, dir) {
+		return "", fmt.Errorf("file_path must be inside the download directory %s", d.root)
+	}
+
+	existing := dir
+	for {
+		if _, err := os.Lstat(existing); err == nil || existing == filepath.Dir(existing) {
+			break
+		}
+		existing = filepath.Dir(existing)
+	}
+
+	realExisting, err := filepath.EvalSymlinks(existing)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve directory: %w", err)
+	}
+	if !within(d.root, realExisting) {
+		return "", fmt.Errorf("file_path must be inside the download directory %s", d.root)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve directory: %w", err)
+	}
+	if !within(d.root, realDir) {
+		return "", fmt.Errorf("file_path must be inside the download directory %s", d.root)
+	}
+
+	target := filepath.Join(realDir, name)
+	if info, err := os.Lstat(target); err == nil && !info.Mode().IsRegular() {
+		return "", fmt.Errorf("%s exists and is not a regular file", path)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	return target, nil
+}
+
+// within reports whether path is root or lies below it
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// headWriter passes writes through to w, keeping the first 512 bytes for
+// content sniffing
+type headWriter struct {
+	w    io.Writer
+	head []byte
+}
+
+// Write implements io.Writer
+func (h *headWriter) Write(p []byte) (int, error) {
+	if n := 512 - len(h.head); n > 0 {
+		h.head = append(h.head, p[:min(n, len(p))]...)
+	}
+	return h.w.Write(p)
+}
+
+// detectMIMEType sniffs the type of an attachment from its first bytes,
+// falling back to the type the server declared when sniffing is inconclusive
+func detectMIMEType(head []byte, declared string) string {
+	sniffed := http.DetectContentType(head)
+	if sniffed != "application/octet-stream" {
+		return sniffed
+	}
+
+	if mediaType, params, err := mime.ParseMediaType(declared); err == nil {
+		return mime.FormatMediaType(mediaType, params)
+	}
+	return sniffed
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// roundTripFunc serves requests in-process so tests can stand in for uploads.linear.app
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func newTestDownloader(t *testing.T, maxBytes int64, files map[string]string) *downloader {
+	httpCli := &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		if req.Header.Get("Authorization") != "test_api_key" {
+			t.Errorf("Expected the API key to be sent, got %q", req.Header.Get("Authorization"))
+		}
+		if req.Header.Get("Content-Type") != "" {
+			t.Errorf("Expected no Content-Type on a GET, got %q", req.Header.Get("Content-Type"))
+		}
+
+		body, ok := files[req.URL.Path]
+		if !ok {
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}
+		}
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Header:        http.Header{"Content-Type": {"application/octet-stream"}},
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: -1,
+		}
+	})}
+
+	d, err := newDownloader(t.TempDir(), maxBytes, "test_api_key", httpCli)
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+	return d
+}
+
+func TestDownloadToFile(t *testing.T) {
+	d := newTestDownloader(t, 64, map[string]string{
+		"/a/log.txt": "build failed\n",
+		"/a/big.bin": strings.Repeat("x", 65),
+	})
+	ctx := context.Background()
+
+	file, err := d.fetchToFile(ctx, "https://uploads.linear.app/a/log.txt", "logs/log.txt", false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if want := filepath.Join(d.root, "logs", "log.txt"); file.Path != want {
+		t.Errorf("Expected the file to be written to %s, got %s", want, file.Path)
+	}
+	if !strings.HasPrefix(file.MIMEType, "text/plain") || file.Size != 13 {
+		t.Errorf("Expected 13 bytes of text, got %d bytes of %s", file.Size, file.MIMEType)
+	}
+
+	if _, err := d.fetchToFile(ctx, "https://uploads.linear.app/a/log.txt", "logs/log.txt", false); err == nil {
+		t.Errorf("Expected an existing file not to be overwritten")
+	}
+	if _, err := d.fetchToFile(ctx, "https://uploads.linear.app/a/log.txt", "logs/log.txt", true); err != nil {
+		t.Errorf("Expected overwrite to replace the file: %v", err)
+	}
+
+	if _, err := d.fetchToFile(ctx, "https://uploads.linear.app/a/big.bin", "big.bin", false); err == nil {
+		t.Errorf("Expected downloads over the size limit to fail")
+	}
+	if entries, _ := os.ReadDir(d.root); len(entries) != 1 {
+		t.Errorf("Expected no partial files to be left behind, got %d entries", len(entries))
+	}
+
+	if _, err := d.fetchToFile(ctx, "https://example.com/a/log.txt", "other.txt", false); err == nil {
+		t.Errorf("Expected URLs outside uploads.linear.app to be rejected")
+	}
+}
+
+func TestDownloadPathStaysInRoot(t *testing.T) {
+	d := newTestDownloader(t, 64, nil)
+
+	outside := t.TempDir()
+	if err := os.Symlink(outside, filepath.Join(d.root, "escape")); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+
+	for _, path := range []string{
+		"../outside.txt",
+		filepath.Join(outside, "file.txt"),
+		"escape/file.txt",
+		"escape/new/file.txt",
+		".",
+	} {
+		if _, err := d.resolvePath(path); err == nil {
+			t.Errorf("Expected %s to be rejected", path)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(outside, "new")); err == nil {
+		t.Errorf("Expected no directories to be created outside the download directory")
+	}
+
+	if _, err := d.resolvePath(filepath.Join(d.root, "nested", "file.txt")); err != nil {
+		t.Errorf("Expected absolute paths inside the download directory to be accepted: %v", err)
+	}
+}
+
+func TestDownloadInline(t *testing.T) {
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("\x00", 16)
+	d := newTestDownloader(t, 64, map[string]string{"/a/shot.png": png})
+
+	file, err := d.fetchInline(context.Background(), "https://uploads.linear.app/a/shot.png")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if file.MIMEType != "image/png" || string(file.Data) != png {
+		t.Errorf("Expected the PNG to be sniffed and returned, got %s", file.MIMEType)
+	}
+}
@@ -0,0 +1,91 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+)
+
+// ArchiveIssue archives an issue. Archived issues are hidden from views and
+// searches but can be restored with UnarchiveIssue.
+func (c *Client) ArchiveIssue(issueID string) error {
+	return c.ArchiveIssueContext(context.Background(), issueID)
+}
+
+// ArchiveIssueContext is like ArchiveIssue but honors ctx for cancellation and deadlines
+func (c *Client) ArchiveIssueContext(ctx context.Context, issueID string) error {
+	return c.archiveMutation(ctx, "archive_issue.graphql", "issueArchive", issueID, "issue archive")
+}
+
+// UnarchiveIssue restores an issue that was archived or moved to the trash
+func (c *Client) UnarchiveIssue(issueID string) error {
+	return c.UnarchiveIssueContext(context.Background(), issueID)
+}
+
+// UnarchiveIssueContext is like UnarchiveIssue but honors ctx for cancellation and deadlines
+func (c *Client) UnarchiveIssueContext(ctx context.Context, issueID string) error {
+	return c.archiveMutation(ctx, "unarchive_issue.graphql", "issueUnarchive", issueID, "issue unarchive")
+}
+
+// DeleteIssue moves an issue to the trash. Linear deletes trashed issues
+// permanently after a grace period; until then UnarchiveIssue restores them.
+func (c *Client) DeleteIssue(issueID string) error {
+	return c.DeleteIssueContext(context.Background(), issueID)
+}
+
+// DeleteIssueContext is like DeleteIssue but honors ctx for cancellation and deadlines
+func (c *Client) DeleteIssueContext(ctx context.Context, issueID string) error {
+	return c.archiveMutation(ctx, "delete_issue.graphql", "issueDelete", issueID, "issue deletion")
+}
+
+// ArchiveProject archives a project. Archived projects can be restored with
+// UnarchiveProject.
+func (c *Client) ArchiveProject(projectID string) error {
+	return c.ArchiveProjectContext(context.Background(), projectID)
+}
+
+// ArchiveProjectContext is like ArchiveProject but honors ctx for cancellation and deadlines
+func (c *Client) ArchiveProjectContext(ctx context.Context, projectID string) error {
+	return c.archiveMutation(ctx, "archive_project.graphql", "projectArchive", projectID, "project archive")
+}
+
+// UnarchiveProject restores a project that was archived or moved to the trash
+func (c *Client) UnarchiveProject(projectID string) error {
+	return c.UnarchiveProjectContext(context.Background(), projectID)
+}
+
+// UnarchiveProjectContext is like UnarchiveProject but honors ctx for cancellation and deadlines
+func (c *Client) UnarchiveProjectContext(ctx context.Context, projectID string) error {
+	return c.archiveMutation(ctx, "unarchive_project.graphql", "projectUnarchive", projectID, "project unarchive")
+}
+
+// DeleteProject moves a project to the trash, from which UnarchiveProject
+// restores it
+func (c *Client) DeleteProject(projectID string) error {
+	return c.DeleteProjectContext(context.Background(), projectID)
+}
+
+// DeleteProjectContext is like DeleteProject but honors ctx for cancellation and deadlines
+func (c *Client) DeleteProjectContext(ctx context.Context, projectID string) error {
+	return c.archiveMutation(ctx, "delete_project.graphql", "projectDelete", projectID, "project deletion")
+}
+
+// archiveMutation runs one of the archive, unarchive or delete mutations,
+// which all take an ID and report success in the same way
+func (c *Client) archiveMutation(ctx context.Context, filename, field, id, action string) error {
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	data, err := queryInto[map[string]*struct {
+		Success bool `json:"success"`
+	}](ctx, c, filename, variables)
+	if err != nil {
+		return err
+	}
+
+	if payload := (*data)[field]; payload == nil || !payload.Success {
+		return fmt.Errorf("%s was not successful", action)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestArchiveAndDelete(t *testing.T) {
+	var mutations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		name := strings.TrimPrefix(strings.SplitN(req.Query, "(", 2)[0], "mutation ")
+		mutations = append(mutations, name+" "+req.Variables["id"].(string))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch name {
+		case "ArchiveIssue":
+			w.Write([]byte(`{"data": {"issueArchive": {"success": true}}}`))
+		case "UnarchiveIssue":
+			w.Write([]byte(`{"data": {"issueUnarchive": {"success": true}}}`))
+		case "DeleteProject":
+			w.Write([]byte(`{"data": {"projectDelete": {"success": false}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	if err := client.ArchiveIssue("issue1"); err != nil {
+		t.Errorf("Unexpected error archiving issue: %v", err)
+	}
+
+	if err := client.UnarchiveIssue("issue1"); err != nil {
+		t.Errorf("Unexpected error unarchiving issue: %v", err)
+	}
+
+	if err := client.DeleteProject("project1"); err == nil {
+		t.Errorf("Expected an error when Linear reports the deletion was not successful")
+	}
+
+	want := "ArchiveIssue issue1,UnarchiveIssue issue1,DeleteProject project1"
+	if got := strings.Join(mutations, ","); got != want {
+		t.Errorf("Expected mutations %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,152 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+)
+
+// Attachment represents a link attached to a Linear issue, such as an
+// uploaded file, a pull request or an error tracker event
+type Attachment struct {
+	ID         string                 `json:"id"`
+	Title      string                 `json:"title"`
+	Subtitle   string                 `json:"subtitle,omitempty"`
+	URL        string                 `json:"url"`
+	SourceType string                 `json:"sourceType,omitempty"` // The integration that created the attachment, e.g. github or sentry
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`   // Source-specific details, e.g. a pull request's status
+	Creator    *User                  `json:"creator,omitempty"`
+	CreatedAt  string                 `json:"createdAt"`
+	UpdatedAt  string                 `json:"updatedAt,omitempty"`
+}
+
+// attachmentPayload is the result of an attachment mutation
+type attachmentPayload struct {
+	Success    bool        `json:"success"`
+	Attachment *Attachment `json:"attachment"`
+}
+
+// GetIssueAttachments returns the attachments of an issue
+func (c *Client) GetIssueAttachments(issueID string) ([]Attachment, error) {
+	return c.GetIssueAttachmentsContext(context.Background(), issueID)
+}
+
+// GetIssueAttachmentsContext is like GetIssueAttachments but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueAttachmentsContext(ctx context.Context, issueID string) ([]Attachment, error) {
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": maxPageSize,
+	}
+
+	data, err := queryInto[struct {
+		Issue *struct {
+			Attachments Nodes[Attachment] `json:"attachments"`
+		} `json:"issue"`
+	}](ctx, c, "get_issue_attachments.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Issue == nil {
+		return nil, &NotFoundError{Resource: "issue", Ref: issueID}
+	}
+
+	return data.Issue.Attachments, nil
+}
+
+// CreateAttachmentInput represents input for attaching a URL to an issue
+type CreateAttachmentInput struct {
+	IssueID  string                 `json:"issueId"`
+	URL      string                 `json:"url"`
+	Title    string                 `json:"title"`
+	Subtitle string                 `json:"subtitle,omitempty"`
+	IconURL  string                 `json:"iconUrl,omitempty"`  // Optional icon shown next to the attachment
+	Metadata map[string]interface{} `json:"metadata,omitempty"` // Optional details to store with the attachment
+}
+
+// CreateAttachment attaches a URL to an issue. Attaching a URL the issue
+// already has updates the existing attachment instead.
+func (c *Client) CreateAttachment(input CreateAttachmentInput) (*Attachment, error) {
+	return c.CreateAttachmentContext(context.Background(), input)
+}
+
+// CreateAttachmentContext is like CreateAttachment but honors ctx for cancellation and deadlines
+func (c *Client) CreateAttachmentContext(ctx context.Context, input CreateAttachmentInput) (*Attachment, error) {
+	// Build the input object
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId": input.IssueID,
+			"url":     input.URL,
+			"title":   input.Title,
+		},
+	}
+
+	// Add optional fields to the input object
+	inputObj := variables["input"].(map[string]interface{})
+
+	if input.Subtitle != "" {
+		inputObj["subtitle"] = input.Subtitle
+	}
+
+	if input.IconURL != "" {
+		inputObj["iconUrl"] = input.IconURL
+	}
+
+	if len(input.Metadata) > 0 {
+		inputObj["metadata"] = input.Metadata
+	}
+
+	data, err := queryInto[struct {
+		AttachmentCreate attachmentPayload `json:"attachmentCreate"`
+	}](ctx, c, "create_attachment.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.AttachmentCreate.Success || data.AttachmentCreate.Attachment == nil {
+		return nil, fmt.Errorf("attachment creation was not successful")
+	}
+
+	return data.AttachmentCreate.Attachment, nil
+}
+
+// LinkURL attaches a URL to an issue, letting Linear recognize links to
+// services it integrates with (such as pull requests) and fill in their
+// details. The title is optional.
+func (c *Client) LinkURL(issueID, url, title string) (*Attachment, error) {
+	return c.LinkURLContext(context.Background(), issueID, url, title)
+}
+
+// LinkURLContext is like LinkURL but honors ctx for cancellation and deadlines
+func (c *Client) LinkURLContext(ctx context.Context, issueID, url, title string) (*Attachment, error) {
+	variables := map[string]interface{}{
+		"issueId": issueID,
+		"url":     url,
+	}
+
+	if title != "" {
+		variables["title"] = title
+	}
+
+	data, err := queryInto[struct {
+		AttachmentLinkURL attachmentPayload `json:"attachmentLinkURL"`
+	}](ctx, c, "link_url.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.AttachmentLinkURL.Success || data.AttachmentLinkURL.Attachment == nil {
+		return nil, fmt.Errorf("linking URL was not successful")
+	}
+
+	return data.AttachmentLinkURL.Attachment, nil
+}
+
+// DeleteAttachment removes an attachment from its issue
+func (c *Client) DeleteAttachment(attachmentID string) error {
+	return c.DeleteAttachmentContext(context.Background(), attachmentID)
+}
+
+// DeleteAttachmentContext is like DeleteAttachment but honors ctx for cancellation and deadlines
+func (c *Client) DeleteAttachmentContext(ctx context.Context, attachmentID string) error {
+	return c.archiveMutation(ctx, "delete_attachment.graphql", "attachmentDelete", attachmentID, "attachment deletion")
+}
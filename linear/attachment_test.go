@@ -0,0 +1,65 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIssueAttachments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetIssueAttachments"):
+			w.Write([]byte(`{"data": {"issue": {"attachments": {"nodes": [
+				{"id": "att1", "title": "Fix login redirect", "url": "https://github.com/acme/app/pull/42", "sourceType": "github", "metadata": {"status": "merged"}}
+			]}}}}`))
+		case strings.HasPrefix(req.Query, "query GetIssue"):
+			w.Write([]byte(`{"data": {"issue": {"id": "issue1", "identifier": "ENG-1"}}}`))
+		case strings.HasPrefix(req.Query, "mutation LinkURL"):
+			if _, ok := req.Variables["title"]; ok {
+				t.Errorf("Expected an empty title to be left for Linear to fill in, got %v", req.Variables)
+			}
+			if req.Variables["issueId"] != "issue1" || req.Variables["url"] != "https://ci.example.com/builds/7" {
+				t.Errorf("Unexpected link variables: %v", req.Variables)
+			}
+
+			w.Write([]byte(`{"data": {"attachmentLinkURL": {"success": true, "attachment":
+				{"id": "att2", "title": "Build #7", "url": "https://ci.example.com/builds/7"}
+			}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	issue, err := client.GetIssue("issue1", &GetIssueOptions{IncludeAttachments: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(issue.Attachments) != 1 || issue.Attachments[0].SourceType != "github" || issue.Attachments[0].Metadata["status"] != "merged" {
+		t.Errorf("Expected the pull request attachment with its metadata, got %+v", issue.Attachments)
+	}
+
+	attachment, err := client.LinkURL("issue1", "https://ci.example.com/builds/7", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if attachment.ID != "att2" || attachment.Title != "Build #7" {
+		t.Errorf("Expected the linked attachment, got %+v", attachment)
+	}
+}
@@ -0,0 +1,309 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// batchChunkSize is how many aliased mutations are sent in one request
+const batchChunkSize = 25
+
+// batchFallbackWorkers is how many single-issue mutations run concurrently
+// when a batched request has to be retried one issue at a time
+const batchFallbackWorkers = 4
+
+// BatchResult is the outcome of one item of a batch operation
+type BatchResult struct {
+	Ref   string `json:"ref"`             // The issue ID for updates, or the title for creates
+	Issue *Issue `json:"issue,omitempty"` // The created or updated issue, nil if the item failed
+	Err   error  `json:"-"`               // Why the item failed, nil on success
+}
+
+// MarshalJSON includes the error message, which encoding/json would drop
+func (r BatchResult) MarshalJSON() ([]byte, error) {
+	type result BatchResult
+	var msg string
+	if r.Err != nil {
+		msg = r.Err.Error()
+	}
+	return json.Marshal(struct {
+		result
+		Error string `json:"error,omitempty"`
+	}{result(r), msg})
+}
+
+// IssueUpdate pairs an issue with the changes to make to it
+type IssueUpdate struct {
+	IssueID string
+	Input   UpdateIssueInput
+}
+
+// BatchCreateIssues creates several issues using as few requests as possible.
+// Results are returned in the order of inputs; items that failed have Err
+// set while the others are still created.
+func (c *Client) BatchCreateIssues(inputs []CreateIssueInput) ([]BatchResult, error) {
+	return c.BatchCreateIssuesContext(context.Background(), inputs)
+}
+
+// BatchCreateIssuesContext is like BatchCreateIssues but honors ctx for cancellation and deadlines
+func (c *Client) BatchCreateIssuesContext(ctx context.Context, inputs []CreateIssueInput) ([]BatchResult, error) {
+	results := make([]BatchResult, len(inputs))
+	items := make([]batchItem, 0, len(inputs))
+	for i, input := range inputs {
+		results[i].Ref = input.Title
+
+		inputObj, err := c.createIssueInput(ctx, input)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		items = append(items, batchItem{index: i, args: map[string]interface{}{"input": inputObj}})
+	}
+
+	err := c.runBatch(ctx, issueCreateBatch, items, results, func(ctx context.Context, i int) (*Issue, error) {
+		return c.CreateIssueContext(ctx, inputs[i])
+	})
+	return results, err
+}
+
+// BatchUpdateIssues applies several issue updates using as few requests as
+// possible. Results are returned in the order of updates; items that failed
+// have Err set while the others are still applied.
+func (c *Client) BatchUpdateIssues(updates []IssueUpdate) ([]BatchResult, error) {
+	return c.BatchUpdateIssuesContext(context.Background(), updates)
+}
+
+// BatchUpdateIssuesContext is like BatchUpdateIssues but honors ctx for cancellation and deadlines
+func (c *Client) BatchUpdateIssuesContext(ctx context.Context, updates []IssueUpdate) ([]BatchResult, error) {
+	results := make([]BatchResult, len(updates))
+	items := make([]batchItem, 0, len(updates))
+	for i, update := range updates {
+		results[i].Ref = update.IssueID
+
+		inputObj, err := c.updateIssueInput(ctx, update.IssueID, update.Input)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		items = append(items, batchItem{index: i, args: map[string]interface{}{"id": update.IssueID, "input": inputObj}})
+	}
+
+	err := c.runBatch(ctx, issueUpdateBatch, items, results, func(ctx context.Context, i int) (*Issue, error) {
+		return c.UpdateIssueContext(ctx, updates[i].IssueID, updates[i].Input)
+	})
+	return results, err
+}
+
+// batchMutation describes an issue mutation that can be repeated under
+// aliases in a single request
+type batchMutation struct {
+	operation  string      // Operation name of the batched document
+	field      string      // Mutation field, e.g. issueUpdate
+	args       [][2]string // Argument names and GraphQL types
+	idempotent bool        // Whether the mutation can safely be sent again after an unknown outcome
+}
+
+var (
+	issueCreateBatch = batchMutation{
+		operation: "BatchCreateIssues",
+		field:     "issueCreate",
+		args:      [][2]string{{"input", "IssueCreateInput!"}},
+	}
+	issueUpdateBatch = batchMutation{
+		operation:  "BatchUpdateIssues",
+		field:      "issueUpdate",
+		args:       [][2]string{{"id", "String!"}, {"input", "IssueUpdateInput!"}},
+		idempotent: true,
+	}
+)
+
+// batchItem is one mutation of a batch, with the index of its result
+type batchItem struct {
+	index int
+	args  map[string]interface{}
+}
+
+// document builds a mutation that runs m once per item, aliased item0, item1, …
+func (m batchMutation) document(n int) string {
+	var params, fields strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&fields, "  item%d: %s(", i, m.field)
+		for j, arg := range m.args {
+			if i > 0 || j > 0 {
+				params.WriteString(", ")
+			}
+			fmt.Fprintf(&params, "$%s%d: %s", arg[0], i, arg[1])
+
+			if j > 0 {
+				fields.WriteString(", ")
+			}
+			fmt.Fprintf(&fields, "%s: $%s%d", arg[0], arg[0], i)
+		}
+		fields.WriteString(") {\n    success\n    issue {\n      ...IssueFields\n    }\n  }\n")
+	}
+
+	return fmt.Sprintf("mutation %s(%s) {\n%s}\n", m.operation, params.String(), fields.String())
+}
+
+// runBatch sends items in chunks of aliased mutations, recording the outcome
+// of each in results. When a whole chunk fails, its items are retried one at
+// a time with single if the mutation is idempotent or Linear rejected the
+// chunk without running it; otherwise they fail with ErrOutcomeUnknown. The
+// returned error is only set when ctx ends before every item was attempted.
+func (c *Client) runBatch(ctx context.Context, m batchMutation, items []batchItem, results []BatchResult, single func(context.Context, int) (*Issue, error)) error {
+	for start := 0; start < len(items); start += batchChunkSize {
+		if err := ctx.Err(); err != nil {
+			for _, item := range items[start:] {
+				results[item.index].Err = err
+			}
+			return err
+		}
+
+		chunk := items[start:min(start+batchChunkSize, len(items))]
+		err := c.runBatchChunk(ctx, m, chunk, results)
+		if err == nil {
+			continue
+		}
+
+		if !canRetrySingly(err, m.idempotent) || ctx.Err() != nil {
+			if !m.idempotent && !rejectedBeforeRunning(err) {
+				err = fmt.Errorf("%w: the request failed after Linear may have run some of it; check for the issues before retrying: %w", ErrOutcomeUnknown, err)
+			}
+			for _, item := range chunk {
+				results[item.index].Err = err
+			}
+			continue
+		}
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, batchFallbackWorkers)
+		for _, item := range chunk {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				results[i].Issue, results[i].Err = single(ctx, i)
+			}(item.index)
+		}
+		wg.Wait()
+	}
+
+	return ctx.Err()
+}
+
+// runBatchChunk sends one chunk as a single request. Per-item failures are
+// recorded in results; an error is returned only when the request as a whole
+// failed and no item outcome is known.
+func (c *Client) runBatchChunk(ctx context.Context, m batchMutation, chunk []batchItem, results []BatchResult) error {
+	query, err := withFragments(m.document(len(chunk)))
+	if err != nil {
+		return err
+	}
+
+	variables := map[string]interface{}{}
+	for i, item := range chunk {
+		for _, arg := range m.args {
+			variables[arg[0]+strconv.Itoa(i)] = item.args[arg[0]]
+		}
+	}
+
+	raw, err := c.execute(ctx, query, variables)
+	if raw == nil || len(raw.Data) == 0 || string(raw.Data) == "null" {
+		if err == nil {
+			err = fmt.Errorf("response contained no data")
+		}
+		return err
+	}
+
+	var data map[string]*issuePayload
+	if err := json.Unmarshal(raw.Data, &data); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	// Errors carry the alias of the mutation that raised them in their path
+	itemErrs := map[string][]GraphQLError{}
+	var shared []GraphQLError
+	for _, gqlErr := range raw.Errors {
+		if alias, ok := errorAlias(gqlErr); ok {
+			itemErrs[alias] = append(itemErrs[alias], gqlErr)
+		} else {
+			shared = append(shared, gqlErr)
+		}
+	}
+
+	statusCode := 0
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		statusCode = apiErr.StatusCode
+	}
+
+	for i, item := range chunk {
+		alias := "item" + strconv.Itoa(i)
+		payload := data[alias]
+		switch {
+		case len(itemErrs[alias]) > 0:
+			results[item.index].Err = &APIError{StatusCode: statusCode, Errors: itemErrs[alias]}
+		case payload != nil && payload.Success && payload.Issue != nil:
+			results[item.index].Issue = payload.Issue
+		case len(shared) > 0:
+			results[item.index].Err = &APIError{StatusCode: statusCode, Errors: shared}
+		default:
+			results[item.index].Err = fmt.Errorf("%s was not successful", m.field)
+		}
+	}
+
+	return nil
+}
+
+// errorAlias returns the alias at the start of a GraphQL error's path
+func errorAlias(err GraphQLError) (string, bool) {
+	if len(err.Path) == 0 {
+		return "", false
+	}
+	alias, ok := err.Path[0].(string)
+	return alias, ok && strings.HasPrefix(alias, "item")
+}
+
+// canRetrySingly reports whether the items of a failed batch request can be
+// sent again one at a time. Idempotent mutations can always be retried;
+// others only when Linear rejected the request before running any of it.
+func canRetrySingly(err error, idempotent bool) bool {
+	if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden) || errors.Is(err, ErrRateLimited) {
+		return false
+	}
+
+	return idempotent || rejectedBeforeRunning(err)
+}
+
+// rejectedBeforeRunning reports whether a failed request is known not to
+// have run any of its mutations. That holds for GraphQL errors none of which
+// carry a path, such as validation failures of the whole document. An error
+// with a path was raised while executing a mutation, and since the payloads
+// are non-null it nulls out the whole response even though other aliased
+// mutations may already have run.
+func rejectedBeforeRunning(err error) bool {
+	if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden) || errors.Is(err, ErrRateLimited) {
+		return true
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, gqlErr := range apiErr.Errors {
+		if len(gqlErr.Path) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+package linear
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestBatchUpdateIssuesReportsPartialFailure(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		if !strings.HasPrefix(req.Query, "mutation BatchUpdateIssues") {
+			t.Errorf("Unexpected query: %s", req.Query)
+			return
+		}
+
+		if req.Variables["id0"] != "issue1" || req.Variables["id2"] != "issue3" {
+			t.Errorf("Expected aliased issue IDs, got %v", req.Variables)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"data": {
+				"item0": {"success": true, "issue": {"id": "issue1", "identifier": "ENG-1"}},
+				"item1": null,
+				"item2": {"success": true, "issue": {"id": "issue3", "identifier": "ENG-3"}}
+			},
+			"errors": [{
+				"message": "Entity not found",
+				"path": ["item1"],
+				"extensions": {"code": "INPUT_ERROR", "userPresentableMessage": "Issue not found"}
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	priority := 2
+	var updates []IssueUpdate
+	for _, id := range []string{"issue1", "issue2", "issue3"} {
+		updates = append(updates, IssueUpdate{IssueID: id, Input: UpdateIssueInput{Priority: &priority}})
+	}
+
+	results, err := client.BatchUpdateIssues(updates)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected a single request, got %d", requests)
+	}
+
+	if results[0].Err != nil || results[0].Issue.Identifier != "ENG-1" || results[2].Err != nil {
+		t.Errorf("Expected the first and last updates to succeed, got %+v", results)
+	}
+
+	var apiErr *APIError
+	if !errors.As(results[1].Err, &apiErr) || apiErr.UserMessage() != "Issue not found" {
+		t.Errorf("Expected the second update to fail with its own error, got %v", results[1].Err)
+	}
+}
+
+func TestBatchCreateIssuesFallsBackWhenRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.HasPrefix(req.Query, "mutation BatchCreateIssues"):
+			// The document as a whole is rejected before anything runs
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors": [{"message": "Query too complex", "extensions": {"code": "GRAPHQL_VALIDATION_FAILED"}}]}`))
+		case strings.HasPrefix(req.Query, "mutation CreateIssue"):
+			input := req.Variables["input"].(map[string]interface{})
+			w.WriteHeader(http.StatusOK)
+			if input["title"] == "Broken" {
+				w.Write([]byte(`{"data": null, "errors": [{"message": "title is invalid", "extensions": {"code": "INPUT_ERROR"}}]}`))
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+				"issueCreate": map[string]interface{}{"success": true, "issue": map[string]interface{}{"id": "new", "title": input["title"]}},
+			}})
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	results, err := client.BatchCreateIssues([]CreateIssueInput{
+		{TeamID: "team1", Title: "First"},
+		{TeamID: "team1", Title: "Broken"},
+		{TeamID: "team1", Title: "Third"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for i, want := range []bool{true, false, true} {
+		if ok := results[i].Err == nil; ok != want {
+			t.Errorf("Expected result %d (%s) success to be %v, got error %v", i, results[i].Ref, want, results[i].Err)
+		}
+	}
+}
+
+func TestBatchCreateIssuesDoesNotRetryAfterExecutionError(t *testing.T) {
+	var singles int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case strings.HasPrefix(req.Query, "mutation BatchCreateIssues"):
+			// One create failed while running, nulling the whole response
+			// after the others may already have been created
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data": null, "errors": [{"message": "Entity not found", "path": ["item1"], "extensions": {"code": "INPUT_ERROR"}}]}`))
+		case strings.HasPrefix(req.Query, "mutation CreateIssue"):
+			atomic.AddInt32(&singles, 1)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data": {"issueCreate": {"success": true, "issue": {"id": "dup"}}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	results, err := client.BatchCreateIssues([]CreateIssueInput{
+		{TeamID: "team1", Title: "First"},
+		{TeamID: "missing", Title: "Second"},
+		{TeamID: "team1", Title: "Third"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if singles != 0 {
+		t.Errorf("Expected no single CreateIssue calls, got %d", singles)
+	}
+
+	for i, result := range results {
+		if !errors.Is(result.Err, ErrOutcomeUnknown) {
+			t.Errorf("Expected result %d to have an unknown outcome, got %v", i, result.Err)
+		}
+	}
+}
+
+func TestBatchMutationDocument(t *testing.T) {
+	doc := issueUpdateBatch.document(2)
+
+	for _, want := range []string{
+		"mutation BatchUpdateIssues($id0: String!, $input0: IssueUpdateInput!, $id1: String!, $input1: IssueUpdateInput!)",
+		"item0: issueUpdate(id: $id0, input: $input0)",
+		"item1: issueUpdate(id: $id1, input: $input1)",
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("Expected document to contain %q, got:\n%s", want, doc)
+		}
+	}
+
+	if _, err := withFragments(doc); err != nil {
+		t.Errorf("Expected fragments to resolve: %v", err)
+	}
+}
@@ -2,10 +2,13 @@ package linear
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"strings"
+	"sync"
+	"time"
 )
 
 // DefaultAPIURL is the default URL for Linear's GraphQL API
@@ -16,6 +19,10 @@ type Client struct {
 	apiKey  string
 	apiURL  string
 	httpCli *http.Client
+	retry   RetryPolicy
+
+	mu        sync.Mutex // Guards rateLimit
+	rateLimit RateLimitStatus
 }
 
 // ClientOption is a function that configures a Client
@@ -41,6 +48,7 @@ func NewClient(apiKey string, opts ...ClientOption) *Client {
 		apiKey:  apiKey,
 		apiURL:  DefaultAPIURL,
 		httpCli: http.DefaultClient,
+		retry:   DefaultRetryPolicy(),
 	}
 
 	for _, opt := range opts {
@@ -62,13 +70,68 @@ type GraphQLResponse struct {
 	Errors []GraphQLError         `json:"errors,omitempty"`
 }
 
-// GraphQLError represents a GraphQL error
-type GraphQLError struct {
-	Message string `json:"message"`
+// rawGraphQLResponse is a GraphQL response whose data has not been decoded yet
+type rawGraphQLResponse struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
 }
 
 // ExecuteGraphQL makes a GraphQL request to the Linear API
 func (c *Client) ExecuteGraphQL(query string, variables map[string]interface{}) (*GraphQLResponse, error) {
+	return c.ExecuteGraphQLContext(context.Background(), query, variables)
+}
+
+// ExecuteGraphQLContext is like ExecuteGraphQL but honors ctx for cancellation and deadlines
+func (c *Client) ExecuteGraphQLContext(ctx context.Context, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
+	raw, err := c.execute(ctx, query, variables)
+	if raw == nil {
+		return nil, err
+	}
+
+	result := &GraphQLResponse{Errors: raw.Errors}
+	if len(raw.Data) > 0 {
+		if decodeErr := json.Unmarshal(raw.Data, &result.Data); decodeErr != nil && err == nil {
+			return nil, fmt.Errorf("failed to decode response: %w", decodeErr)
+		}
+	}
+
+	return result, err
+}
+
+// ExecuteGraphQLInto makes a GraphQL request to the Linear API and decodes
+// the response data directly into a T
+func ExecuteGraphQLInto[T any](ctx context.Context, c *Client, query string, variables map[string]interface{}) (*T, error) {
+	raw, err := c.execute(ctx, query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raw.Data) == 0 || string(raw.Data) == "null" {
+		return nil, fmt.Errorf("response contained no data")
+	}
+
+	var data T
+	if err := json.Unmarshal(raw.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &data, nil
+}
+
+// queryInto loads a GraphQL document by filename and executes it, decoding
+// the response data into a T
+func queryInto[T any](ctx context.Context, c *Client, filename string, variables map[string]interface{}) (*T, error) {
+	query, err := getGraphQLQuery(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", filename, err)
+	}
+
+	return ExecuteGraphQLInto[T](ctx, c, query, variables)
+}
+
+// execute sends a GraphQL request, retrying transient failures according to
+// the client's retry policy
+func (c *Client) execute(ctx context.Context, query string, variables map[string]interface{}) (*rawGraphQLResponse, error) {
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -79,9 +142,39 @@ func (c *Client) ExecuteGraphQL(query string, variables map[string]interface{})
 		return nil, fmt.Errorf("failed to marshal request body: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.apiURL, bytes.NewBuffer(jsonBody))
+	// Mutations are not idempotent, so only retry them when explicitly allowed
+	canRetry := c.retry.RetryMutations || !isMutation(query)
+
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, fmt.Errorf("failed waiting for rate limit reset: %w", err)
+		}
+
+		result := c.doGraphQL(ctx, jsonBody)
+		if result.err == nil || !result.retryable || !canRetry || attempt >= c.retry.MaxRetries {
+			return result.resp, result.err
+		}
+
+		delay := max(c.retry.backoff(attempt), c.retry.capDelay(result.retryAfter))
+		if err := sleep(ctx, delay); err != nil {
+			return result.resp, result.err
+		}
+	}
+}
+
+// attemptResult is the outcome of a single round trip to the Linear API
+type attemptResult struct {
+	resp       *rawGraphQLResponse
+	err        error
+	retryable  bool          // Whether the failure is transient and the request may be retried
+	retryAfter time.Duration // Delay requested by the server before retrying
+}
+
+// doGraphQL sends a single GraphQL request and classifies any failure
+func (c *Client) doGraphQL(ctx context.Context, jsonBody []byte) attemptResult {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL, bytes.NewBuffer(jsonBody))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return attemptResult{err: fmt.Errorf("failed to create request: %w", err)}
 	}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -89,62 +182,60 @@ func (c *Client) ExecuteGraphQL(query string, variables map[string]interface{})
 
 	resp, err := c.httpCli.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		// Network errors are transient unless the caller gave up
+		return attemptResult{
+			err:       fmt.Errorf("failed to make request: %w", err),
+			retryable: ctx.Err() == nil,
+		}
 	}
 	defer resp.Body.Close()
 
-	var result GraphQLResponse
+	c.updateRateLimit(resp.Header)
+
+	rateLimited := resp.StatusCode == http.StatusTooManyRequests
+	retryable := rateLimited || resp.StatusCode >= http.StatusInternalServerError
+
+	var result rawGraphQLResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		if resp.StatusCode != http.StatusOK {
 			// If we can't decode the response body and status is not OK,
 			// return the HTTP error instead
-			return nil, fmt.Errorf("received non-OK response: %s", resp.Status)
+			return attemptResult{
+				err:        &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status},
+				retryable:  retryable,
+				retryAfter: retryAfterIf(rateLimited, resp.Header),
+			}
 		}
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		return attemptResult{err: fmt.Errorf("failed to decode response: %w", err)}
 	}
 
-	// If we have GraphQL errors, format them nicely
 	if len(result.Errors) > 0 {
-		errorMsgs := make([]string, 0, len(result.Errors))
-		for _, err := range result.Errors {
-			errorMsgs = append(errorMsgs, err.Message)
+		apiErr := &APIError{StatusCode: resp.StatusCode, Errors: result.Errors}
+		rateLimited = rateLimited || errors.Is(apiErr, ErrRateLimited)
+		return attemptResult{
+			resp:       &result,
+			err:        apiErr,
+			retryable:  retryable || rateLimited,
+			retryAfter: retryAfterIf(rateLimited, resp.Header),
 		}
-		return &result, fmt.Errorf("GraphQL errors: %s", strings.Join(errorMsgs, "; "))
 	}
 
-	return &result, nil
-}
-
-// Helper functions for safely extracting values from maps
-func safeGetString(m map[string]interface{}, key string) string {
-	if val, ok := m[key]; ok {
-		if strVal, ok := val.(string); ok {
-			return strVal
+	if resp.StatusCode != http.StatusOK {
+		return attemptResult{
+			resp:       &result,
+			err:        &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status},
+			retryable:  retryable,
+			retryAfter: retryAfterIf(rateLimited, resp.Header),
 		}
 	}
-	return ""
-}
 
-func safeGetInt(m map[string]interface{}, key string) int {
-	if val, ok := m[key]; ok {
-		switch v := val.(type) {
-		case int:
-			return v
-		case float64:
-			return int(v)
-		}
-	}
-	return 0
+	return attemptResult{resp: &result}
 }
 
-func safeGetFloat64(m map[string]interface{}, key string) float64 {
-	if val, ok := m[key]; ok {
-		switch v := val.(type) {
-		case float64:
-			return v
-		case int:
-			return float64(v)
-		}
+// retryAfterIf returns the server-requested delay for rate-limited responses
+func retryAfterIf(rateLimited bool, header http.Header) time.Duration {
+	if !rateLimited {
+		return 0
 	}
-	return 0
+	return retryAfter(header, time.Now())
 }
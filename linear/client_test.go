@@ -1,9 +1,16 @@
 package linear
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -123,3 +130,626 @@ func TestExecuteGraphQLWithErrors(t *testing.T) {
 		t.Errorf("Expected error message to be 'Test GraphQL error', got '%s'", resp.Errors[0].Message)
 	}
 }
+
+func TestGetAllTeamIssuesFollowsCursors(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		// Serve two pages, keyed off the cursor in the request
+		if req.Variables["after"] == nil {
+			w.Write([]byte(`{"data": {"team": {"issues": {
+				"nodes": [{"id": "issue1", "identifier": "ENG-1"}, {"id": "issue2", "identifier": "ENG-2"}],
+				"pageInfo": {"hasNextPage": true, "endCursor": "cursor1"}
+			}}}}`))
+			return
+		}
+
+		if req.Variables["after"] != "cursor1" {
+			t.Errorf("Expected after to be cursor1, got %v", req.Variables["after"])
+		}
+
+		w.Write([]byte(`{"data": {"team": {"issues": {
+			"nodes": [{"id": "issue3", "identifier": "ENG-3"}],
+			"pageInfo": {"hasNextPage": false, "endCursor": "cursor2"}
+		}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	page, err := client.GetTeamIssues("team1", &GetTeamIssuesOptions{First: 2})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor != "cursor1" {
+		t.Errorf("Expected next page at cursor1, got %+v", page.PageInfo)
+	}
+
+	issues, err := client.GetAllTeamIssues("team1", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(issues) != 3 {
+		t.Fatalf("Expected 3 issues, got %d", len(issues))
+	}
+
+	if issues[2].Identifier != "ENG-3" {
+		t.Errorf("Expected last issue to be ENG-3, got %s", issues[2].Identifier)
+	}
+
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+
+	// A limit stops pagination once enough issues are collected
+	requests = 0
+	issues, err = client.GetAllTeamIssues("team1", 2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(issues) != 2 || requests != 1 {
+		t.Errorf("Expected 2 issues from 1 request, got %d issues from %d requests", len(issues), requests)
+	}
+}
+
+func TestExecuteGraphQLContextCancellation(t *testing.T) {
+	// Create a test server that never responds until the test finishes
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetViewerContext(ctx)
+	if err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestExecuteGraphQLRetries(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 2,
+		BaseDelay:  time.Millisecond,
+		MaxDelay:   10 * time.Millisecond,
+	}
+
+	t.Run("retries server errors and rate limits for queries", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+
+			switch requests {
+			case 1:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			case 2:
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"errors": [{"message": "Rate limit exceeded", "extensions": {"code": "RATELIMITED"}}]}`))
+			default:
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"viewer": {"id": "user123", "name": "Test User", "email": "test@example.com"}}}`))
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient("test_api_key", WithURL(server.URL), WithRetryPolicy(policy))
+
+		user, err := client.GetViewer()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if user.ID != "user123" {
+			t.Errorf("Expected user ID to be user123, got %s", user.ID)
+		}
+
+		if requests != 3 {
+			t.Errorf("Expected 3 requests, got %d", requests)
+		}
+	})
+
+	t.Run("does not retry mutations by default", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := NewClient("test_api_key", WithURL(server.URL), WithRetryPolicy(policy))
+
+		if _, err := client.CreateIssue(CreateIssueInput{TeamID: "team1", Title: "Test"}); err == nil {
+			t.Fatal("Expected an error, but got nil")
+		}
+
+		if requests != 1 {
+			t.Errorf("Expected 1 request, got %d", requests)
+		}
+
+		// Opting in allows mutations to be retried
+		requests = 0
+		allowMutations := policy
+		allowMutations.RetryMutations = true
+		client = NewClient("test_api_key", WithURL(server.URL), WithRetryPolicy(allowMutations))
+
+		if _, err := client.CreateIssue(CreateIssueInput{TeamID: "team1", Title: "Test"}); err == nil {
+			t.Fatal("Expected an error, but got nil")
+		}
+
+		if requests != 3 {
+			t.Errorf("Expected 3 requests, got %d", requests)
+		}
+	})
+
+	t.Run("tracks rate limit headers", func(t *testing.T) {
+		reset := time.Now().Add(time.Hour).UnixMilli()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-RateLimit-Requests-Limit", "1500")
+			w.Header().Set("X-RateLimit-Requests-Remaining", "3")
+			w.Header().Set("X-RateLimit-Requests-Reset", strconv.FormatInt(reset, 10))
+			w.Header().Set("X-Complexity", "42")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data": {"viewer": {"id": "user123", "name": "Test User", "email": "test@example.com"}}}`))
+		}))
+		defer server.Close()
+
+		client := NewClient("test_api_key", WithURL(server.URL))
+
+		if _, err := client.GetViewer(); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		rl := client.RateLimit()
+		if rl.RequestsLimit != 1500 || rl.RequestsRemaining != 3 || rl.LastComplexity != 42 {
+			t.Errorf("Unexpected rate limit status: %+v", rl)
+		}
+
+		if rl.RequestsReset.UnixMilli() != reset {
+			t.Errorf("Expected requests reset at %d, got %d", reset, rl.RequestsReset.UnixMilli())
+		}
+
+		// Below the default threshold the client wants to wait for the reset
+		if pause := client.rateLimitPause(time.Now()); pause <= 0 {
+			t.Errorf("Expected a proactive pause, got %s", pause)
+		}
+	})
+}
+
+func TestGraphQLErrorClassification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"data": null,
+			"errors": [
+				{
+					"message": "Entity not found",
+					"path": ["issue"],
+					"locations": [{"line": 2, "column": 3}],
+					"extensions": {
+						"code": "INPUT_ERROR",
+						"type": "invalid input",
+						"userError": true,
+						"userPresentableMessage": "Could not find referenced Issue."
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	_, err := client.GetIssue("missing", nil)
+	if err == nil {
+		t.Fatal("Expected an error, but got nil")
+	}
+
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected error to be ErrNotFound, got %v", err)
+	}
+
+	if errors.Is(err, ErrRateLimited) {
+		t.Error("Expected error not to be ErrRateLimited")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected an *APIError, got %T", err)
+	}
+
+	gqlErr := apiErr.Errors[0]
+	if len(gqlErr.Path) != 1 || gqlErr.Path[0] != "issue" {
+		t.Errorf("Expected path [issue], got %v", gqlErr.Path)
+	}
+
+	if len(gqlErr.Locations) != 1 || gqlErr.Locations[0].Line != 2 {
+		t.Errorf("Expected location at line 2, got %v", gqlErr.Locations)
+	}
+
+	if apiErr.UserMessage() != "Could not find referenced Issue." {
+		t.Errorf("Expected user presentable message, got %q", apiErr.UserMessage())
+	}
+
+	// HTTP status codes are classified too
+	httpErr := &HTTPError{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized"}
+	if !errors.Is(httpErr, ErrUnauthorized) {
+		t.Errorf("Expected 401 to be ErrUnauthorized")
+	}
+
+	// Even when the body decodes but carries no GraphQL errors
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message": "Invalid API key"}`))
+	}))
+	defer unauthorized.Close()
+
+	if _, err := NewClient("bad_api_key", WithURL(unauthorized.URL)).GetTeams(); !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("Expected a 401 with a JSON body to be ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestGraphQLQueriesResolveFragments(t *testing.T) {
+	entries, err := graphqlFS.ReadDir("graphql")
+	if err != nil {
+		t.Fatalf("Failed to read embedded queries: %v", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		query, err := getGraphQLQuery(entry.Name())
+		if err != nil {
+			t.Errorf("Failed to load %s: %v", entry.Name(), err)
+			continue
+		}
+
+		// Every spread fragment must be defined exactly once
+		for _, match := range fragmentSpreadRe.FindAllStringSubmatch(query, -1) {
+			if match[1] == "on" {
+				continue
+			}
+			if n := strings.Count(query, "fragment "+match[1]+" on"); n != 1 {
+				t.Errorf("%s: expected fragment %s to be defined once, found %d", entry.Name(), match[1], n)
+			}
+		}
+	}
+}
+
+// fieldTypes maps "ParentType.field" to the object type the field returns,
+// or for connections the type of their nodes. It covers every field that
+// fragments are spread under so TestFragmentTypeConditions can check them.
+var fieldTypes = map[string]string{
+	"Query.cycle":            "Cycle",
+	"Query.issue":            "Issue",
+	"Query.issueLabels":      "IssueLabel",
+	"Query.issues":           "Issue",
+	"Query.notifications":    "Notification",
+	"Query.project":          "Project",
+	"Query.projectMilestone": "ProjectMilestone",
+	"Query.projects":         "Project",
+	"Query.searchIssues":     "IssueSearchResult",
+	"Query.team":             "Team",
+	"Query.teams":            "Team",
+	"Query.user":             "User",
+	"Query.users":            "User",
+	"Query.viewer":           "User",
+
+	"Mutation.attachmentCreate":       "AttachmentPayload",
+	"Mutation.attachmentLinkURL":      "AttachmentPayload",
+	"Mutation.commentCreate":          "CommentPayload",
+	"Mutation.commentUpdate":          "CommentPayload",
+	"Mutation.issueAddLabel":          "IssuePayload",
+	"Mutation.issueCreate":            "IssuePayload",
+	"Mutation.issueLabelCreate":       "IssueLabelPayload",
+	"Mutation.issueRelationCreate":    "IssueRelationPayload",
+	"Mutation.issueRemoveLabel":       "IssuePayload",
+	"Mutation.issueUpdate":            "IssuePayload",
+	"Mutation.notificationUpdate":     "NotificationPayload",
+	"Mutation.projectCreate":          "ProjectPayload",
+	"Mutation.projectMilestoneCreate": "ProjectMilestonePayload",
+	"Mutation.projectMilestoneUpdate": "ProjectMilestonePayload",
+	"Mutation.projectUpdate":          "ProjectPayload",
+	"Mutation.projectUpdateCreate":    "ProjectUpdatePayload",
+
+	"AttachmentPayload.attachment":             "Attachment",
+	"CommentPayload.comment":                   "Comment",
+	"IssueLabelPayload.issueLabel":             "IssueLabel",
+	"IssuePayload.issue":                       "Issue",
+	"IssueRelationPayload.issueRelation":       "IssueRelation",
+	"NotificationPayload.notification":         "Notification",
+	"ProjectMilestonePayload.projectMilestone": "ProjectMilestone",
+	"ProjectPayload.project":                   "Project",
+	"ProjectUpdatePayload.projectUpdate":       "ProjectUpdate",
+
+	"Issue.assignee":         "User",
+	"Issue.attachments":      "Attachment",
+	"Issue.children":         "Issue",
+	"Issue.comments":         "Comment",
+	"Issue.cycle":            "Cycle",
+	"Issue.inverseRelations": "IssueRelation",
+	"Issue.labels":           "IssueLabel",
+	"Issue.parent":           "Issue",
+	"Issue.project":          "Project",
+	"Issue.projectMilestone": "ProjectMilestone",
+	"Issue.relations":        "IssueRelation",
+	"Issue.state":            "WorkflowState",
+	"Issue.team":             "Team",
+
+	"IssueSearchResult.assignee":         "User",
+	"IssueSearchResult.attachments":      "Attachment",
+	"IssueSearchResult.children":         "Issue",
+	"IssueSearchResult.comments":         "Comment",
+	"IssueSearchResult.cycle":            "Cycle",
+	"IssueSearchResult.inverseRelations": "IssueRelation",
+	"IssueSearchResult.labels":           "IssueLabel",
+	"IssueSearchResult.parent":           "Issue",
+	"IssueSearchResult.project":          "Project",
+	"IssueSearchResult.projectMilestone": "ProjectMilestone",
+	"IssueSearchResult.relations":        "IssueRelation",
+	"IssueSearchResult.state":            "WorkflowState",
+	"IssueSearchResult.team":             "Team",
+
+	"Attachment.creator":         "User",
+	"Comment.parent":             "Comment",
+	"Comment.user":               "User",
+	"Cycle.issues":               "Issue",
+	"Cycle.team":                 "Team",
+	"IssueLabel.parent":          "IssueLabel",
+	"IssueRelation.issue":        "Issue",
+	"IssueRelation.relatedIssue": "Issue",
+
+	"Notification.actor":          "User",
+	"IssueNotification.comment":   "Comment",
+	"IssueNotification.issue":     "Issue",
+	"ProjectNotification.project": "Project",
+
+	"Project.issues":            "Issue",
+	"Project.lead":              "User",
+	"Project.projectMilestones": "ProjectMilestone",
+	"Project.projectUpdates":    "ProjectUpdate",
+	"Project.teams":             "Team",
+	"ProjectMilestone.project":  "Project",
+	"ProjectUpdate.project":     "Project",
+	"ProjectUpdate.user":        "User",
+
+	"Team.activeCycle": "Cycle",
+	"Team.cycles":      "Cycle",
+	"Team.issues":      "Issue",
+	"Team.labels":      "IssueLabel",
+	"Team.members":     "User",
+	"Team.projects":    "Project",
+	"Team.states":      "WorkflowState",
+}
+
+var (
+	graphqlTokenRe = regexp.MustCompile(`#[^\n]*|"(?:[^"\\]|\\.)*"|\.\.\.|[{}()]|\$?\w+|\S`)
+	graphqlNameRe  = regexp.MustCompile(`^\w+$`)
+)
+
+// enclosingTypes calls spread with the name of every fragment spread in doc
+// and the type it is spread into, or "" when fieldTypes doesn't say
+func enclosingTypes(doc string, spread func(name, typ string)) {
+	tokens := graphqlTokenRe.FindAllString(doc, -1)
+
+	var (
+		stack  []string // Type of each open selection set
+		field  string   // Last field name seen in the current selection set
+		next   string   // Type of the next selection set, when given explicitly
+		parens int
+	)
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if parens > 0 {
+			switch tok {
+			case "(":
+				parens++
+			case ")":
+				parens--
+			}
+			continue
+		}
+
+		switch tok {
+		case "(":
+			parens++
+		case "query":
+			next = "Query"
+		case "mutation":
+			next = "Mutation"
+		case "on":
+			if i+1 < len(tokens) {
+				next = tokens[i+1]
+				i++
+			}
+		case "...":
+			if i+1 < len(tokens) && tokens[i+1] != "on" {
+				parent := ""
+				if len(stack) > 0 {
+					parent = stack[len(stack)-1]
+				}
+				spread(tokens[i+1], parent)
+				i++
+			}
+		case "{":
+			typ := next
+			if typ == "" && len(stack) > 0 {
+				typ = fieldType(stack[len(stack)-1], field)
+			}
+			stack = append(stack, typ)
+			field, next = "", ""
+		case "}":
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		default:
+			if graphqlNameRe.MatchString(tok) {
+				field = tok
+			}
+		}
+	}
+}
+
+// fieldType returns the type of a field of parent according to fieldTypes.
+// Connections are looked through, so their nodes have the parent's type.
+func fieldType(parent, field string) string {
+	switch {
+	case field == "pageInfo":
+		return "PageInfo"
+	case parent == "":
+		return ""
+	case field == "nodes" || field == "node" || field == "edges":
+		return parent
+	}
+	return fieldTypes[parent+"."+field]
+}
+
+func TestFragmentTypeConditions(t *testing.T) {
+	defs, err := loadFragments()
+	if err != nil {
+		t.Fatalf("Failed to load fragments: %v", err)
+	}
+
+	docs := map[string]string{
+		"BatchCreateIssues": issueCreateBatch.document(1),
+		"BatchUpdateIssues": issueUpdateBatch.document(1),
+	}
+	for name, def := range defs {
+		docs["fragment "+name] = def
+	}
+
+	entries, err := graphqlFS.ReadDir("graphql")
+	if err != nil {
+		t.Fatalf("Failed to read embedded queries: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := graphqlFS.ReadFile("graphql/" + entry.Name())
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", entry.Name(), err)
+		}
+		docs[entry.Name()] = string(data)
+	}
+
+	// A fragment can only be spread into the type it is declared on, which
+	// Linear enforces by rejecting the whole document
+	for name, doc := range docs {
+		enclosingTypes(doc, func(fragment, typ string) {
+			match := fragmentDefRe.FindStringSubmatch(defs[fragment])
+			switch {
+			case match == nil:
+				t.Errorf("%s: unknown fragment %s", name, fragment)
+			case typ == "":
+				t.Errorf("%s: cannot tell which type %s is spread into; add its field to fieldTypes", name, fragment)
+			case typ != match[2]:
+				t.Errorf("%s: fragment %s is declared on %s but spread into %s", name, fragment, match[2], typ)
+			}
+		})
+	}
+}
+
+func TestTypedDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": {"projectCreate": {"success": true, "project": {
+			"id": "project1",
+			"name": "Test Project",
+			"sortOrder": 1.5,
+			"lead": {"id": "user1", "name": "Lead", "email": "lead@example.com"},
+			"teams": {"nodes": [{"id": "team1", "name": "Engineering", "key": "ENG"}]}
+		}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	project, err := client.CreateProject(CreateProjectInput{Name: "Test Project"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if project.ID != "project1" || project.SortOrder != 1.5 {
+		t.Errorf("Unexpected project: %+v", project)
+	}
+
+	if project.Lead == nil || project.Lead.Email != "lead@example.com" {
+		t.Errorf("Expected lead email to be decoded, got %+v", project.Lead)
+	}
+
+	if len(project.Teams) != 1 || project.Teams[0].Key != "ENG" {
+		t.Errorf("Expected teams to be unwrapped from nodes, got %+v", project.Teams)
+	}
+}
+
+func TestIssueLabelsDecodeFromConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": {"issue": {
+			"id": "issue1",
+			"identifier": "ENG-1",
+			"labels": {"nodes": [
+				{"id": "label1", "name": "bug", "parent": {"id": "group1", "name": "Type"}}
+			]}
+		}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	issue, err := client.GetIssue("issue1", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(issue.Labels) != 1 || issue.Labels[0].Name != "bug" {
+		t.Fatalf("Expected the bug label, got %+v", issue.Labels)
+	}
+
+	if issue.Labels[0].Parent == nil || issue.Labels[0].Parent.Name != "Type" {
+		t.Errorf("Expected the label group to be decoded, got %+v", issue.Labels[0].Parent)
+	}
+
+	// Labels are flattened to a plain array when encoded
+	encoded, err := json.Marshal(issue)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(string(encoded), `"labels":[{"id":"label1"`) {
+		t.Errorf("Expected labels to encode as an array, got %s", encoded)
+	}
+}
@@ -0,0 +1,187 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+)
+
+// Comment represents a comment on a Linear issue
+type Comment struct {
+	ID        string   `json:"id"`
+	Body      string   `json:"body"`
+	User      *User    `json:"user,omitempty"`
+	Parent    *Comment `json:"parent,omitempty"` // Set when the comment is a reply in a thread
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updatedAt,omitempty"`
+	EditedAt  string   `json:"editedAt,omitempty"`
+	URL       string   `json:"url,omitempty"`
+}
+
+// commentPayload is the result of a comment mutation
+type commentPayload struct {
+	Success bool     `json:"success"`
+	Comment *Comment `json:"comment"`
+}
+
+// GetIssueCommentsOptions contains optional parameters for listing issue comments
+type GetIssueCommentsOptions struct {
+	First int    // Number of comments to fetch (max 100)
+	After string // Cursor to start fetching after
+}
+
+// GetIssueComments returns a page of comments on a specific issue
+func (c *Client) GetIssueComments(issueID string, opts *GetIssueCommentsOptions) (*Page[Comment], error) {
+	return c.GetIssueCommentsContext(context.Background(), issueID, opts)
+}
+
+// GetIssueCommentsContext is like GetIssueComments but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueCommentsContext(ctx context.Context, issueID string, opts *GetIssueCommentsOptions) (*Page[Comment], error) {
+	variables := map[string]interface{}{
+		"id": issueID,
+	}
+
+	if opts == nil {
+		opts = &GetIssueCommentsOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	data, err := queryInto[struct {
+		Issue *struct {
+			Comments Page[Comment] `json:"comments"`
+		} `json:"issue"`
+	}](ctx, c, "get_issue_comments.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Issue == nil {
+		return nil, &NotFoundError{Resource: "issue", Ref: issueID}
+	}
+
+	return &data.Issue.Comments, nil
+}
+
+// GetAllIssueComments returns up to limit comments on an issue, following page
+// cursors as needed. A limit of 0 or less returns every comment.
+func (c *Client) GetAllIssueComments(issueID string, limit int) ([]Comment, error) {
+	return c.GetAllIssueCommentsContext(context.Background(), issueID, limit)
+}
+
+// GetAllIssueCommentsContext is like GetAllIssueComments but honors ctx for cancellation and deadlines
+func (c *Client) GetAllIssueCommentsContext(ctx context.Context, issueID string, limit int) ([]Comment, error) {
+	return CollectPages(limit, func(after string) (*Page[Comment], error) {
+		return c.GetIssueCommentsContext(ctx, issueID, &GetIssueCommentsOptions{First: maxPageSize, After: after})
+	})
+}
+
+// CreateCommentInput represents input for creating a new comment
+type CreateCommentInput struct {
+	IssueID  string `json:"issueId"`
+	Body     string `json:"body"`               // Markdown body of the comment
+	ParentID string `json:"parentId,omitempty"` // Optional parent comment ID to reply in a thread
+}
+
+// CreateComment creates a new comment on an issue
+func (c *Client) CreateComment(input CreateCommentInput) (*Comment, error) {
+	return c.CreateCommentContext(context.Background(), input)
+}
+
+// CreateCommentContext is like CreateComment but honors ctx for cancellation and deadlines
+func (c *Client) CreateCommentContext(ctx context.Context, input CreateCommentInput) (*Comment, error) {
+	// Build the input object
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId": input.IssueID,
+			"body":    input.Body,
+		},
+	}
+
+	// Add optional fields to the input object
+	inputObj := variables["input"].(map[string]interface{})
+
+	if input.ParentID != "" {
+		inputObj["parentId"] = input.ParentID
+	}
+
+	data, err := queryInto[struct {
+		CommentCreate commentPayload `json:"commentCreate"`
+	}](ctx, c, "create_comment.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.CommentCreate.Success || data.CommentCreate.Comment == nil {
+		return nil, fmt.Errorf("comment creation was not successful")
+	}
+
+	return data.CommentCreate.Comment, nil
+}
+
+// ReplyToComment creates a reply to an existing comment on an issue
+func (c *Client) ReplyToComment(issueID, parentID, body string) (*Comment, error) {
+	return c.ReplyToCommentContext(context.Background(), issueID, parentID, body)
+}
+
+// ReplyToCommentContext is like ReplyToComment but honors ctx for cancellation and deadlines
+func (c *Client) ReplyToCommentContext(ctx context.Context, issueID, parentID, body string) (*Comment, error) {
+	return c.CreateCommentContext(ctx, CreateCommentInput{
+		IssueID:  issueID,
+		Body:     body,
+		ParentID: parentID,
+	})
+}
+
+// UpdateComment replaces the body of an existing comment
+func (c *Client) UpdateComment(commentID, body string) (*Comment, error) {
+	return c.UpdateCommentContext(context.Background(), commentID, body)
+}
+
+// UpdateCommentContext is like UpdateComment but honors ctx for cancellation and deadlines
+func (c *Client) UpdateCommentContext(ctx context.Context, commentID, body string) (*Comment, error) {
+	variables := map[string]interface{}{
+		"id": commentID,
+		"input": map[string]interface{}{
+			"body": body,
+		},
+	}
+
+	data, err := queryInto[struct {
+		CommentUpdate commentPayload `json:"commentUpdate"`
+	}](ctx, c, "update_comment.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.CommentUpdate.Success || data.CommentUpdate.Comment == nil {
+		return nil, fmt.Errorf("comment update was not successful")
+	}
+
+	return data.CommentUpdate.Comment, nil
+}
+
+// DeleteComment deletes a comment
+func (c *Client) DeleteComment(commentID string) error {
+	return c.DeleteCommentContext(context.Background(), commentID)
+}
+
+// DeleteCommentContext is like DeleteComment but honors ctx for cancellation and deadlines
+func (c *Client) DeleteCommentContext(ctx context.Context, commentID string) error {
+	variables := map[string]interface{}{
+		"id": commentID,
+	}
+
+	data, err := queryInto[struct {
+		CommentDelete struct {
+			Success bool `json:"success"`
+		} `json:"commentDelete"`
+	}](ctx, c, "delete_comment.graphql", variables)
+	if err != nil {
+		return err
+	}
+
+	if !data.CommentDelete.Success {
+		return fmt.Errorf("comment deletion was not successful")
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package linear
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIssueComments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetIssueComments"):
+			switch {
+			case req.Variables["id"] == "missing":
+				w.Write([]byte(`{"data": {"issue": null}}`))
+			case req.Variables["after"] == nil:
+				w.Write([]byte(`{"data": {"issue": {"comments": {"nodes": [
+					{"id": "c1", "body": "First", "user": {"id": "u1", "name": "Ada"}}
+				], "pageInfo": {"hasNextPage": true, "endCursor": "cursor1"}}}}}`))
+			case req.Variables["after"] == "cursor1":
+				w.Write([]byte(`{"data": {"issue": {"comments": {"nodes": [
+					{"id": "c2", "body": "Second", "parent": {"id": "c1"}}
+				], "pageInfo": {"hasNextPage": false}}}}}`))
+			default:
+				t.Errorf("Unexpected cursor: %v", req.Variables["after"])
+			}
+		case strings.HasPrefix(req.Query, "mutation CreateComment"):
+			input := req.Variables["input"].(map[string]interface{})
+			if input["issueId"] != "issue1" || input["parentId"] != "c1" || input["body"] != "Agreed" {
+				t.Errorf("Expected a reply to c1 on issue1, got %v", input)
+			}
+
+			w.Write([]byte(`{"data": {"commentCreate": {"success": true, "comment":
+				{"id": "c3", "body": "Agreed", "parent": {"id": "c1"}}
+			}}}`))
+		case strings.HasPrefix(req.Query, "mutation UpdateComment"):
+			w.Write([]byte(`{"data": {"commentUpdate": {"success": true, "comment": {"id": "c1", "body": "Edited", "editedAt": "2024-05-01T00:00:00Z"}}}}`))
+		case strings.HasPrefix(req.Query, "mutation DeleteComment"):
+			w.Write([]byte(`{"data": {"commentDelete": {"success": false}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	page, err := client.GetIssueComments("issue1", &GetIssueCommentsOptions{First: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(page.Nodes) != 1 || !page.PageInfo.HasNextPage || page.PageInfo.EndCursor != "cursor1" {
+		t.Errorf("Expected the first page with a cursor, got %+v", page)
+	}
+
+	comments, err := client.GetAllIssueComments("issue1", 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(comments) != 2 || comments[1].Parent == nil || comments[1].Parent.ID != "c1" {
+		t.Errorf("Expected both pages with the reply threaded under c1, got %+v", comments)
+	}
+
+	reply, err := client.ReplyToComment("issue1", "c1", "Agreed")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if reply.Parent == nil || reply.Parent.ID != "c1" {
+		t.Errorf("Expected the reply to be threaded under c1, got %+v", reply)
+	}
+
+	if comment, err := client.UpdateComment("c1", "Edited"); err != nil || comment.EditedAt == "" {
+		t.Errorf("Expected the edited comment, got %+v, %v", comment, err)
+	}
+
+	if err := client.DeleteComment("c1"); err == nil {
+		t.Errorf("Expected an unsuccessful deletion to fail")
+	}
+
+	if _, err := client.GetIssueComments("missing", nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a missing issue to be not found, got %v", err)
+	}
+}
@@ -0,0 +1,302 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cycle represents a Linear cycle (sprint)
+type Cycle struct {
+	ID             string  `json:"id"`
+	Number         int     `json:"number"`
+	Name           string  `json:"name,omitempty"`
+	Description    string  `json:"description,omitempty"`
+	StartsAt       string  `json:"startsAt,omitempty"`
+	EndsAt         string  `json:"endsAt,omitempty"`
+	CompletedAt    string  `json:"completedAt,omitempty"`
+	Progress       float64 `json:"progress"`                 // Fraction of the scope that is completed, from 0 to 1
+	Scope          float64 `json:"scope,omitempty"`          // Current scope of the cycle in estimate points
+	CompletedScope float64 `json:"completedScope,omitempty"` // Completed scope of the cycle in estimate points
+	IsActive       bool    `json:"isActive,omitempty"`
+	IsNext         bool    `json:"isNext,omitempty"`
+	IsPrevious     bool    `json:"isPrevious,omitempty"`
+	Team           *Team   `json:"team,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Linear reports scope as a daily
+// history, so the latest entry is taken as the current scope.
+func (c *Cycle) UnmarshalJSON(data []byte) error {
+	type cycle Cycle
+	var node struct {
+		cycle
+		ScopeHistory          []float64 `json:"scopeHistory"`
+		CompletedScopeHistory []float64 `json:"completedScopeHistory"`
+	}
+	if err := json.Unmarshal(data, &node); err != nil {
+		return err
+	}
+
+	*c = Cycle(node.cycle)
+	if n := len(node.ScopeHistory); n > 0 {
+		c.Scope = node.ScopeHistory[n-1]
+	}
+	if n := len(node.CompletedScopeHistory); n > 0 {
+		c.CompletedScope = node.CompletedScopeHistory[n-1]
+	}
+	return nil
+}
+
+// Relative cycle references accepted wherever a cycle ID is expected
+const (
+	CycleCurrent  = "current"
+	CycleNext     = "next"
+	CyclePrevious = "previous"
+)
+
+// GetTeamCyclesOptions contains optional parameters for listing team cycles
+type GetTeamCyclesOptions struct {
+	First       int    // Number of cycles to fetch (max 100)
+	After       string // Cursor to start fetching after
+	IncludePast bool   // Whether to include completed cycles; only current and upcoming cycles are returned otherwise
+}
+
+// GetTeamCycles returns a page of cycles for a specific team
+func (c *Client) GetTeamCycles(teamID string, opts *GetTeamCyclesOptions) (*Page[Cycle], error) {
+	return c.GetTeamCyclesContext(context.Background(), teamID, opts)
+}
+
+// GetTeamCyclesContext is like GetTeamCycles but honors ctx for cancellation and deadlines
+func (c *Client) GetTeamCyclesContext(ctx context.Context, teamID string, opts *GetTeamCyclesOptions) (*Page[Cycle], error) {
+	if opts == nil {
+		opts = &GetTeamCyclesOptions{}
+	}
+
+	var filter map[string]interface{}
+	if !opts.IncludePast {
+		filter = map[string]interface{}{
+			"isPast": map[string]interface{}{"eq": false},
+		}
+	}
+
+	return c.getTeamCycles(ctx, teamID, opts.First, opts.After, filter)
+}
+
+// GetAllTeamCycles returns up to limit cycles for a team, following page
+// cursors as needed. A limit of 0 or less returns every cycle.
+func (c *Client) GetAllTeamCycles(teamID string, opts *GetTeamCyclesOptions, limit int) ([]Cycle, error) {
+	return c.GetAllTeamCyclesContext(context.Background(), teamID, opts, limit)
+}
+
+// GetAllTeamCyclesContext is like GetAllTeamCycles but honors ctx for cancellation and deadlines
+func (c *Client) GetAllTeamCyclesContext(ctx context.Context, teamID string, opts *GetTeamCyclesOptions, limit int) ([]Cycle, error) {
+	pageOpts := GetTeamCyclesOptions{First: maxPageSize}
+	if opts != nil {
+		pageOpts.IncludePast = opts.IncludePast
+	}
+
+	return CollectPages(limit, func(after string) (*Page[Cycle], error) {
+		pageOpts.After = after
+		return c.GetTeamCyclesContext(ctx, teamID, &pageOpts)
+	})
+}
+
+// getTeamCycles fetches a page of a team's cycles matching a CycleFilter
+func (c *Client) getTeamCycles(ctx context.Context, teamID string, first int, after string, filter map[string]interface{}) (*Page[Cycle], error) {
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+	paginationVariables(variables, first, after)
+
+	if filter != nil {
+		variables["filter"] = filter
+	}
+
+	data, err := queryInto[struct {
+		Team *struct {
+			Cycles Page[Cycle] `json:"cycles"`
+		} `json:"team"`
+	}](ctx, c, "get_team_cycles.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Team == nil {
+		return nil, &NotFoundError{Resource: "team", Ref: teamID}
+	}
+
+	return &data.Team.Cycles, nil
+}
+
+// GetActiveCycle returns a team's currently active cycle
+func (c *Client) GetActiveCycle(teamID string) (*Cycle, error) {
+	return c.GetActiveCycleContext(context.Background(), teamID)
+}
+
+// GetActiveCycleContext is like GetActiveCycle but honors ctx for cancellation and deadlines
+func (c *Client) GetActiveCycleContext(ctx context.Context, teamID string) (*Cycle, error) {
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	data, err := queryInto[struct {
+		Team *struct {
+			ActiveCycle *Cycle `json:"activeCycle"`
+		} `json:"team"`
+	}](ctx, c, "get_active_cycle.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Team == nil {
+		return nil, &NotFoundError{Resource: "team", Ref: teamID}
+	}
+
+	if data.Team.ActiveCycle == nil {
+		return nil, &NotFoundError{Resource: "cycle", Ref: fmt.Sprintf("%q for team %s", CycleCurrent, teamID)}
+	}
+
+	return data.Team.ActiveCycle, nil
+}
+
+// GetCycle returns a single cycle by ID
+func (c *Client) GetCycle(cycleID string) (*Cycle, error) {
+	return c.GetCycleContext(context.Background(), cycleID)
+}
+
+// GetCycleContext is like GetCycle but honors ctx for cancellation and deadlines
+func (c *Client) GetCycleContext(ctx context.Context, cycleID string) (*Cycle, error) {
+	variables := map[string]interface{}{
+		"id": cycleID,
+	}
+
+	data, err := queryInto[struct {
+		Cycle *Cycle `json:"cycle"`
+	}](ctx, c, "get_cycle.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Cycle == nil {
+		return nil, &NotFoundError{Resource: "cycle", Ref: cycleID}
+	}
+
+	return data.Cycle, nil
+}
+
+// ResolveCycle finds a team's cycle by ID, number (e.g. "12") or relative
+// reference ("current", "next" or "previous")
+func (c *Client) ResolveCycle(teamID, ref string) (*Cycle, error) {
+	return c.ResolveCycleContext(context.Background(), teamID, ref)
+}
+
+// ResolveCycleContext is like ResolveCycle but honors ctx for cancellation and deadlines
+func (c *Client) ResolveCycleContext(ctx context.Context, teamID, ref string) (*Cycle, error) {
+	normalized := strings.ToLower(strings.TrimSpace(ref))
+
+	var filter map[string]interface{}
+	switch normalized {
+	case CycleCurrent, "active":
+		return c.GetActiveCycleContext(ctx, teamID)
+	case CycleNext:
+		filter = map[string]interface{}{"isNext": map[string]interface{}{"eq": true}}
+	case CyclePrevious:
+		filter = map[string]interface{}{"isPrevious": map[string]interface{}{"eq": true}}
+	default:
+		number, err := strconv.Atoi(strings.TrimPrefix(normalized, "#"))
+		if err != nil {
+			return c.GetCycleContext(ctx, ref)
+		}
+		filter = map[string]interface{}{"number": map[string]interface{}{"eq": number}}
+	}
+
+	cycles, err := c.getTeamCycles(ctx, teamID, 1, "", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cycles.Nodes) == 0 {
+		return nil, &NotFoundError{Resource: "cycle", Ref: fmt.Sprintf("%q for team %s", ref, teamID)}
+	}
+
+	return &cycles.Nodes[0], nil
+}
+
+// isRelativeCycleRef reports whether ref needs a team to be resolved to a cycle ID
+func isRelativeCycleRef(ref string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(ref))
+	switch normalized {
+	case CycleCurrent, "active", CycleNext, CyclePrevious:
+		return true
+	}
+
+	_, err := strconv.Atoi(strings.TrimPrefix(normalized, "#"))
+	return err == nil
+}
+
+// cycleIDFor resolves a CycleID input field, which may be a relative
+// reference, against the issue's team
+func (c *Client) cycleIDFor(ctx context.Context, teamID, ref string) (string, error) {
+	if !isRelativeCycleRef(ref) {
+		return ref, nil
+	}
+
+	cycle, err := c.ResolveCycleContext(ctx, teamID, ref)
+	if err != nil {
+		return "", err
+	}
+	return cycle.ID, nil
+}
+
+// GetCycleIssuesOptions contains optional parameters for listing cycle issues
+type GetCycleIssuesOptions struct {
+	First int    // Number of issues to fetch (max 100)
+	After string // Cursor to start fetching after
+}
+
+// GetCycleIssues returns a page of issues in a specific cycle
+func (c *Client) GetCycleIssues(cycleID string, opts *GetCycleIssuesOptions) (*Page[Issue], error) {
+	return c.GetCycleIssuesContext(context.Background(), cycleID, opts)
+}
+
+// GetCycleIssuesContext is like GetCycleIssues but honors ctx for cancellation and deadlines
+func (c *Client) GetCycleIssuesContext(ctx context.Context, cycleID string, opts *GetCycleIssuesOptions) (*Page[Issue], error) {
+	variables := map[string]interface{}{
+		"id": cycleID,
+	}
+
+	if opts == nil {
+		opts = &GetCycleIssuesOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	data, err := queryInto[struct {
+		Cycle *struct {
+			Issues Page[Issue] `json:"issues"`
+		} `json:"cycle"`
+	}](ctx, c, "get_cycle_issues.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Cycle == nil {
+		return nil, &NotFoundError{Resource: "cycle", Ref: cycleID}
+	}
+
+	return &data.Cycle.Issues, nil
+}
+
+// GetAllCycleIssues returns up to limit issues in a cycle, following page
+// cursors as needed. A limit of 0 or less returns every issue.
+func (c *Client) GetAllCycleIssues(cycleID string, limit int) ([]Issue, error) {
+	return c.GetAllCycleIssuesContext(context.Background(), cycleID, limit)
+}
+
+// GetAllCycleIssuesContext is like GetAllCycleIssues but honors ctx for cancellation and deadlines
+func (c *Client) GetAllCycleIssuesContext(ctx context.Context, cycleID string, limit int) ([]Issue, error) {
+	return CollectPages(limit, func(after string) (*Page[Issue], error) {
+		return c.GetCycleIssuesContext(ctx, cycleID, &GetCycleIssuesOptions{First: maxPageSize, After: after})
+	})
+}
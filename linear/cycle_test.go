@@ -0,0 +1,79 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCycleDecodesLatestScope(t *testing.T) {
+	var cycle Cycle
+	err := json.Unmarshal([]byte(`{
+		"id": "cycle1", "number": 12, "progress": 0.5,
+		"scopeHistory": [4, 6, 8], "completedScopeHistory": [0, 2, 4]
+	}`), &cycle)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if cycle.Number != 12 || cycle.Progress != 0.5 {
+		t.Errorf("Expected cycle 12 at 0.5 progress, got %+v", cycle)
+	}
+
+	if cycle.Scope != 8 || cycle.CompletedScope != 4 {
+		t.Errorf("Expected scope 8 with 4 completed, got %v with %v completed", cycle.Scope, cycle.CompletedScope)
+	}
+}
+
+func TestUpdateIssueResolvesRelativeCycle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetIssue"):
+			w.Write([]byte(`{"data": {"issue": {"id": "issue1", "identifier": "ENG-1", "team": {"id": "team1", "key": "ENG"}}}}`))
+		case strings.HasPrefix(req.Query, "query GetTeamCycles"):
+			if req.Variables["teamId"] != "team1" {
+				t.Errorf("Expected cycles of team1, got %v", req.Variables["teamId"])
+			}
+
+			filter, _ := json.Marshal(req.Variables["filter"])
+			if string(filter) != `{"isNext":{"eq":true}}` {
+				t.Errorf("Expected an isNext filter, got %s", filter)
+			}
+
+			w.Write([]byte(`{"data": {"team": {"cycles": {"nodes": [{"id": "cycle2", "number": 13}], "pageInfo": {"hasNextPage": false}}}}}`))
+		case strings.Contains(req.Query, "issueUpdate"):
+			input := req.Variables["input"].(map[string]interface{})
+			if input["cycleId"] != "cycle2" {
+				t.Errorf("Expected cycleId cycle2, got %v", input["cycleId"])
+			}
+
+			w.Write([]byte(`{"data": {"issueUpdate": {"success": true, "issue": {"id": "issue1", "cycle": {"id": "cycle2", "number": 13}}}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	next := CycleNext
+	issue, err := client.UpdateIssue("issue1", UpdateIssueInput{CycleID: &next})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if issue.Cycle == nil || issue.Cycle.Number != 13 {
+		t.Errorf("Expected issue to be in cycle 13, got %+v", issue.Cycle)
+	}
+}
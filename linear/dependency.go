@@ -0,0 +1,494 @@
+package linear
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Dependency edge types
+const (
+	DependencyBlocks   = "blocks"    // From blocks To
+	DependencySubIssue = "sub_issue" // From is a sub-issue of To, so To is not done until From is
+)
+
+// defaultDependencyDepth and defaultDependencyNodes bound graph traversal
+// when DependencyGraphOptions leaves them unset
+const (
+	defaultDependencyDepth = 3
+	defaultDependencyNodes = 100
+)
+
+// dependencyFetchWorkers is how many issues are fetched concurrently while
+// walking a dependency graph
+const dependencyFetchWorkers = 4
+
+// DependencyNode is an issue in a dependency graph
+type DependencyNode struct {
+	ID            string         `json:"id"`
+	Identifier    string         `json:"identifier"`
+	Title         string         `json:"title"`
+	URL           string         `json:"url,omitempty"`
+	State         *WorkflowState `json:"state,omitempty"`
+	Assignee      *User          `json:"assignee,omitempty"`
+	Depth         int            `json:"depth"`                   // Number of relations away from the nearest root
+	Done          bool           `json:"done"`                    // Whether the issue is completed or canceled
+	Ready         bool           `json:"ready"`                   // Whether the issue is open with nothing open blocking it
+	BlockedBy     []string       `json:"blockedBy,omitempty"`     // Open issues blocking this one, including ones outside the graph
+	OpenSubIssues []string       `json:"openSubIssues,omitempty"` // Open sub-issues that must be finished first
+}
+
+// DependencyEdge is a dependency between two issues in a graph, identified
+// by their identifiers. From must be finished before To.
+type DependencyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"` // DependencyBlocks or DependencySubIssue
+}
+
+// DependencyGraph is the graph of blocking and sub-issue dependencies
+// reachable from one or more root issues
+type DependencyGraph struct {
+	Roots        []string         `json:"roots"`
+	Nodes        []DependencyNode `json:"nodes"`            // Ordered so that prerequisites come before the issues that need them
+	Edges        []DependencyEdge `json:"edges"`            // Dependencies between issues in the graph
+	CriticalPath []string         `json:"criticalPath"`     // Longest chain of open issues that must be finished one after another
+	Ready        []string         `json:"ready"`            // Open issues that can be picked up right now
+	Cycles       [][]string       `json:"cycles,omitempty"` // Groups of issues that block each other in a loop
+	Truncated    bool             `json:"truncated,omitempty"`
+}
+
+// DependencyGraphOptions contains optional parameters for building a dependency graph
+type DependencyGraphOptions struct {
+	MaxDepth         int  // How many relations away from the roots to follow (default 3)
+	MaxNodes         int  // Maximum number of issues to include (default 100)
+	ExcludeSubIssues bool // Whether to only follow blocking relations and not sub-issues
+}
+
+// dependencyIssue is an issue together with the relations and children that
+// make up its dependencies
+type dependencyIssue struct {
+	Issue
+	Relations        Page[IssueRelation] `json:"relations"`
+	InverseRelations Page[IssueRelation] `json:"inverseRelations"`
+	Children         Page[Issue]         `json:"children"`
+}
+
+// GetIssueDependencyGraph walks the blocking relations and sub-issues around
+// an issue and returns them as a dependency graph
+func (c *Client) GetIssueDependencyGraph(issueID string, opts *DependencyGraphOptions) (*DependencyGraph, error) {
+	return c.GetIssueDependencyGraphContext(context.Background(), issueID, opts)
+}
+
+// GetIssueDependencyGraphContext is like GetIssueDependencyGraph but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueDependencyGraphContext(ctx context.Context, issueID string, opts *DependencyGraphOptions) (*DependencyGraph, error) {
+	return c.buildDependencyGraph(ctx, []string{issueID}, opts)
+}
+
+// GetProjectDependencyGraph walks the blocking relations and sub-issues
+// around every issue in a project and returns them as a dependency graph
+func (c *Client) GetProjectDependencyGraph(projectID string, opts *DependencyGraphOptions) (*DependencyGraph, error) {
+	return c.GetProjectDependencyGraphContext(context.Background(), projectID, opts)
+}
+
+// GetProjectDependencyGraphContext is like GetProjectDependencyGraph but honors ctx for cancellation and deadlines
+func (c *Client) GetProjectDependencyGraphContext(ctx context.Context, projectID string, opts *DependencyGraphOptions) (*DependencyGraph, error) {
+	opts = dependencyDefaults(opts)
+
+	issues, err := c.GetAllProjectIssuesContext(ctx, projectID, opts.MaxNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		roots = append(roots, issue.ID)
+	}
+
+	return c.buildDependencyGraph(ctx, roots, opts)
+}
+
+// dependencyDefaults fills in unset options
+func dependencyDefaults(opts *DependencyGraphOptions) *DependencyGraphOptions {
+	resolved := DependencyGraphOptions{}
+	if opts != nil {
+		resolved = *opts
+	}
+
+	if resolved.MaxDepth <= 0 {
+		resolved.MaxDepth = defaultDependencyDepth
+	}
+
+	if resolved.MaxNodes <= 0 {
+		resolved.MaxNodes = defaultDependencyNodes
+	}
+
+	return &resolved
+}
+
+// getIssueDependencies fetches an issue with its relations and children
+func (c *Client) getIssueDependencies(ctx context.Context, issueID string) (*dependencyIssue, error) {
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": maxPageSize,
+	}
+
+	data, err := queryInto[struct {
+		Issue *dependencyIssue `json:"issue"`
+	}](ctx, c, "get_issue_dependencies.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Issue == nil {
+		return nil, &NotFoundError{Resource: "issue", Ref: issueID}
+	}
+
+	return data.Issue, nil
+}
+
+// fetchDependencies fetches several issues concurrently, keeping their order.
+// Issues that no longer exist or are not accessible are left nil unless
+// required is set.
+func (c *Client) fetchDependencies(ctx context.Context, issueIDs []string, required bool) ([]*dependencyIssue, error) {
+	issues := make([]*dependencyIssue, len(issueIDs))
+	errs := make([]error, len(issueIDs))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, dependencyFetchWorkers)
+	for i, issueID := range issueIDs {
+		wg.Add(1)
+		go func(i int, issueID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			issues[i], errs[i] = c.getIssueDependencies(ctx, issueID)
+		}(i, issueID)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil && (required || !errors.Is(err, ErrNotFound)) {
+			return nil, err
+		}
+	}
+
+	return issues, nil
+}
+
+// buildDependencyGraph walks dependencies breadth-first from the roots
+func (c *Client) buildDependencyGraph(ctx context.Context, rootIDs []string, opts *DependencyGraphOptions) (*DependencyGraph, error) {
+	opts = dependencyDefaults(opts)
+	graph := &DependencyGraph{}
+
+	var (
+		order  []*dependencyIssue
+		byID   = map[string]*dependencyIssue{}
+		depth  = map[string]int{}
+		queued = map[string]bool{}
+	)
+
+	frontier := make([]string, 0, len(rootIDs))
+	for _, id := range rootIDs {
+		if !queued[id] {
+			queued[id] = true
+			frontier = append(frontier, id)
+		}
+	}
+
+	for level := 0; len(frontier) > 0; level++ {
+		if remaining := opts.MaxNodes - len(order); len(frontier) > remaining {
+			frontier = frontier[:remaining]
+			graph.Truncated = true
+		}
+
+		issues, err := c.fetchDependencies(ctx, frontier, level == 0)
+		if err != nil {
+			return nil, err
+		}
+
+		var next []string
+		for _, issue := range issues {
+			if issue == nil || byID[issue.ID] != nil {
+				continue
+			}
+
+			byID[issue.ID] = issue
+			depth[issue.ID] = level
+			order = append(order, issue)
+			if level == 0 {
+				graph.Roots = append(graph.Roots, issue.Identifier)
+			}
+
+			if level >= opts.MaxDepth {
+				continue
+			}
+
+			for _, neighbor := range dependencyNeighbors(issue, opts.ExcludeSubIssues) {
+				if !queued[neighbor] {
+					queued[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	// Collect edges between issues that made it into the graph
+	type edgeKey struct{ from, to string }
+	seen := map[edgeKey]bool{}
+	var edges []edgeKey
+	edgeTypes := map[edgeKey]string{}
+	addEdge := func(from, to *Issue, edgeType string) {
+		if from == nil || to == nil || byID[from.ID] == nil || byID[to.ID] == nil {
+			return
+		}
+		key := edgeKey{from.ID, to.ID}
+		if !seen[key] {
+			seen[key] = true
+			edges = append(edges, key)
+			edgeTypes[key] = edgeType
+		}
+	}
+
+	for _, issue := range order {
+		for _, relation := range issue.Relations.Nodes {
+			if relation.Type == RelationBlocks {
+				addEdge(&issue.Issue, relation.RelatedIssue, DependencyBlocks)
+			}
+		}
+		for _, relation := range issue.InverseRelations.Nodes {
+			if relation.Type == RelationBlocks {
+				addEdge(relation.Issue, &issue.Issue, DependencyBlocks)
+			}
+		}
+		if !opts.ExcludeSubIssues {
+			for i := range issue.Children.Nodes {
+				addEdge(&issue.Children.Nodes[i], &issue.Issue, DependencySubIssue)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(order))
+	for _, issue := range order {
+		ids = append(ids, issue.ID)
+	}
+
+	successors := map[string][]string{}
+	predecessors := map[string][]string{}
+	for _, edge := range edges {
+		successors[edge.from] = append(successors[edge.from], edge.to)
+		predecessors[edge.to] = append(predecessors[edge.to], edge.from)
+	}
+
+	// Loops can't be ordered, so dependencies inside one are ignored below
+	components := stronglyConnectedComponents(ids, successors)
+	component := map[string]int{}
+	for i, members := range components {
+		for _, id := range members {
+			component[id] = i
+		}
+		if len(members) > 1 {
+			cycle := make([]string, 0, len(members))
+			for _, id := range members {
+				cycle = append(cycle, byID[id].Identifier)
+			}
+			graph.Cycles = append(graph.Cycles, cycle)
+		}
+	}
+
+	sorted := topologicalOrder(ids, successors, predecessors, component)
+
+	// Longest chain of open issues, counting only dependencies outside loops
+	length := map[string]int{}
+	previous := map[string]string{}
+	end := ""
+	for _, id := range sorted {
+		for _, pred := range predecessors[id] {
+			if component[pred] != component[id] && length[pred] > length[id] {
+				length[id] = length[pred]
+				previous[id] = pred
+			}
+		}
+		if !isDone(byID[id].State) {
+			length[id]++
+		}
+		if end == "" || length[id] > length[end] {
+			end = id
+		}
+	}
+
+	graph.CriticalPath = []string{}
+	for id := end; id != "" && length[end] > 0; id = previous[id] {
+		if !isDone(byID[id].State) {
+			graph.CriticalPath = append([]string{byID[id].Identifier}, graph.CriticalPath...)
+		}
+	}
+
+	graph.Ready = []string{}
+	for _, id := range sorted {
+		node := newDependencyNode(byID[id], depth[id])
+		graph.Nodes = append(graph.Nodes, node)
+		if node.Ready {
+			graph.Ready = append(graph.Ready, node.Identifier)
+		}
+	}
+
+	for _, edge := range edges {
+		graph.Edges = append(graph.Edges, DependencyEdge{
+			From: byID[edge.from].Identifier,
+			To:   byID[edge.to].Identifier,
+			Type: edgeTypes[edge],
+		})
+	}
+
+	return graph, nil
+}
+
+// dependencyNeighbors lists the issues an issue depends on or that depend on it
+func dependencyNeighbors(issue *dependencyIssue, excludeSubIssues bool) []string {
+	var neighbors []string
+	for _, relation := range issue.Relations.Nodes {
+		if relation.Type == RelationBlocks && relation.RelatedIssue != nil {
+			neighbors = append(neighbors, relation.RelatedIssue.ID)
+		}
+	}
+	for _, relation := range issue.InverseRelations.Nodes {
+		if relation.Type == RelationBlocks && relation.Issue != nil {
+			neighbors = append(neighbors, relation.Issue.ID)
+		}
+	}
+	if !excludeSubIssues {
+		for _, child := range issue.Children.Nodes {
+			neighbors = append(neighbors, child.ID)
+		}
+	}
+	return neighbors
+}
+
+// newDependencyNode summarizes a fetched issue for a dependency graph
+func newDependencyNode(issue *dependencyIssue, depth int) DependencyNode {
+	node := DependencyNode{
+		ID:         issue.ID,
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		URL:        issue.URL,
+		State:      issue.State,
+		Assignee:   issue.Assignee,
+		Depth:      depth,
+		Done:       isDone(issue.State),
+	}
+
+	for _, relation := range issue.InverseRelations.Nodes {
+		if relation.Type == RelationBlocks && relation.Issue != nil && !isDone(relation.Issue.State) {
+			node.BlockedBy = append(node.BlockedBy, relation.Issue.Identifier)
+		}
+	}
+
+	for _, child := range issue.Children.Nodes {
+		if !isDone(child.State) {
+			node.OpenSubIssues = append(node.OpenSubIssues, child.Identifier)
+		}
+	}
+
+	node.Ready = !node.Done && len(node.BlockedBy) == 0 && len(node.OpenSubIssues) == 0
+	return node
+}
+
+// isDone reports whether an issue in state is finished, either completed or canceled
+func isDone(state *WorkflowState) bool {
+	return state != nil && (state.Type == StateTypeCompleted || state.Type == StateTypeCanceled)
+}
+
+// stronglyConnectedComponents groups ids into strongly connected components
+// using Tarjan's algorithm. Components are returned in reverse topological order.
+func stronglyConnectedComponents(ids []string, successors map[string][]string) [][]string {
+	var (
+		index      = map[string]int{}
+		lowlink    = map[string]int{}
+		onStack    = map[string]bool{}
+		stack      []string
+		components [][]string
+		counter    int
+	)
+
+	var visit func(id string)
+	visit = func(id string) {
+		index[id] = counter
+		lowlink[id] = counter
+		counter++
+		stack = append(stack, id)
+		onStack[id] = true
+
+		for _, next := range successors[id] {
+			if _, visited := index[next]; !visited {
+				visit(next)
+				lowlink[id] = min(lowlink[id], lowlink[next])
+			} else if onStack[next] {
+				lowlink[id] = min(lowlink[id], index[next])
+			}
+		}
+
+		if lowlink[id] == index[id] {
+			var component []string
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == id {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for _, id := range ids {
+		if _, visited := index[id]; !visited {
+			visit(id)
+		}
+	}
+
+	return components
+}
+
+// topologicalOrder orders ids so that every issue comes after the issues it
+// depends on, ignoring dependencies within the same component. Ties keep the
+// order of ids.
+func topologicalOrder(ids []string, successors, predecessors map[string][]string, component map[string]int) []string {
+	pending := map[string]int{}
+	for _, id := range ids {
+		for _, pred := range predecessors[id] {
+			if component[pred] != component[id] {
+				pending[id]++
+			}
+		}
+	}
+
+	var ready, sorted []string
+	for _, id := range ids {
+		if pending[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, id)
+
+		for _, next := range successors[id] {
+			if component[next] == component[id] {
+				continue
+			}
+			pending[next]--
+			if pending[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+
+	return sorted
+}
@@ -0,0 +1,127 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetIssueDependencyGraph(t *testing.T) {
+	type ref struct {
+		ID         string         `json:"id"`
+		Identifier string         `json:"identifier"`
+		State      *WorkflowState `json:"state"`
+	}
+	type relation struct {
+		Type         string `json:"type"`
+		Issue        ref    `json:"issue"`
+		RelatedIssue ref    `json:"relatedIssue"`
+	}
+	type nodes[T any] struct {
+		Nodes []T `json:"nodes"`
+	}
+
+	states := map[string]string{"i1": StateTypeStarted, "i3": StateTypeCompleted}
+	issue := func(id string) ref {
+		stateType := states[id]
+		if stateType == "" {
+			stateType = StateTypeUnstarted
+		}
+		return ref{ID: id, Identifier: "ENG-" + id[1:], State: &WorkflowState{Type: stateType}}
+	}
+	blocks := func(from, to string) relation {
+		return relation{Type: RelationBlocks, Issue: issue(from), RelatedIssue: issue(to)}
+	}
+
+	// ENG-3 (done) blocks ENG-2, which blocks ENG-1; ENG-4 is a sub-issue of
+	// ENG-1; ENG-5 blocks ENG-3 and is in a blocking loop with ENG-6
+	relations := map[string][]relation{
+		"i2": {blocks("i2", "i1")},
+		"i3": {blocks("i3", "i2")},
+		"i5": {blocks("i5", "i3"), blocks("i5", "i6")},
+		"i6": {blocks("i6", "i5")},
+	}
+	inverse := map[string][]relation{
+		"i1": {blocks("i2", "i1")},
+		"i2": {blocks("i3", "i2")},
+		"i3": {blocks("i5", "i3")},
+		"i5": {blocks("i6", "i5")},
+		"i6": {blocks("i5", "i6")},
+	}
+	children := map[string][]ref{
+		"i1": {issue("i4")},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		id, _ := req.Variables["id"].(string)
+		self := issue(id)
+		data := map[string]interface{}{"issue": map[string]interface{}{
+			"id":               self.ID,
+			"identifier":       self.Identifier,
+			"state":            self.State,
+			"relations":        nodes[relation]{Nodes: relations[id]},
+			"inverseRelations": nodes[relation]{Nodes: inverse[id]},
+			"children":         nodes[ref]{Nodes: children[id]},
+		}}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	graph, err := client.GetIssueDependencyGraph("i1", &DependencyGraphOptions{MaxDepth: 5})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(graph.Nodes) != 6 || graph.Truncated {
+		t.Fatalf("Expected 6 nodes without truncation, got %d (truncated: %v)", len(graph.Nodes), graph.Truncated)
+	}
+
+	if want := []string{"ENG-5", "ENG-2", "ENG-1"}; !reflect.DeepEqual(graph.CriticalPath, want) {
+		t.Errorf("Expected critical path %v, got %v", want, graph.CriticalPath)
+	}
+
+	if want := []string{"ENG-4", "ENG-2"}; !reflect.DeepEqual(graph.Ready, want) {
+		t.Errorf("Expected ready issues %v, got %v", want, graph.Ready)
+	}
+
+	if len(graph.Cycles) != 1 || len(graph.Cycles[0]) != 2 {
+		t.Errorf("Expected one loop between ENG-5 and ENG-6, got %v", graph.Cycles)
+	}
+
+	// Prerequisites come before the issues that need them
+	position := map[string]int{}
+	for i, node := range graph.Nodes {
+		position[node.Identifier] = i
+	}
+	for _, edge := range graph.Edges {
+		if edge.From == "ENG-5" && edge.To == "ENG-6" || edge.From == "ENG-6" && edge.To == "ENG-5" {
+			continue
+		}
+		if position[edge.From] > position[edge.To] {
+			t.Errorf("Expected %s to come before %s", edge.From, edge.To)
+		}
+	}
+
+	// The depth limit stops the walk early
+	graph, err = client.GetIssueDependencyGraph("i1", &DependencyGraphOptions{MaxDepth: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(graph.Nodes) != 3 {
+		t.Errorf("Expected 3 nodes within depth 1, got %d", len(graph.Nodes))
+	}
+}
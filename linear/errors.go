@@ -0,0 +1,210 @@
+package linear
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors for classifying failures with errors.Is
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrValidation   = errors.New("invalid input")
+	ErrAmbiguous    = errors.New("ambiguous reference")
+
+	// ErrOutcomeUnknown marks a failed request that may still have been
+	// applied, so that retrying it could repeat its effects
+	ErrOutcomeUnknown = errors.New("outcome unknown")
+)
+
+// GraphQLErrorLocation is a position in the GraphQL document an error refers to
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// GraphQLErrorExtensions holds the Linear-specific details of a GraphQL error
+type GraphQLErrorExtensions struct {
+	Code                   string `json:"code,omitempty"`
+	Type                   string `json:"type,omitempty"`
+	UserError              bool   `json:"userError,omitempty"`
+	UserPresentableMessage string `json:"userPresentableMessage,omitempty"`
+}
+
+// GraphQLError represents a GraphQL error
+type GraphQLError struct {
+	Message    string                  `json:"message"`
+	Path       []interface{}           `json:"path,omitempty"`
+	Locations  []GraphQLErrorLocation  `json:"locations,omitempty"`
+	Extensions *GraphQLErrorExtensions `json:"extensions,omitempty"`
+}
+
+// Error implements the error interface
+func (e GraphQLError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+
+	path := make([]string, 0, len(e.Path))
+	for _, p := range e.Path {
+		path = append(path, fmt.Sprint(p))
+	}
+	return fmt.Sprintf("%s (at %s)", e.Message, strings.Join(path, "."))
+}
+
+// Is reports whether the error belongs to the class identified by target
+func (e GraphQLError) Is(target error) bool {
+	return target != nil && e.class() == target
+}
+
+// class maps a GraphQL error to one of the sentinel errors, or nil if unknown
+func (e GraphQLError) class() error {
+	var code, typ string
+	if e.Extensions != nil {
+		code = strings.ToUpper(e.Extensions.Code)
+		typ = strings.ToLower(e.Extensions.Type)
+	}
+
+	switch {
+	case code == "RATELIMITED" || typ == "ratelimited":
+		return ErrRateLimited
+	case code == "AUTHENTICATION_ERROR" || typ == "authentication error":
+		return ErrUnauthorized
+	case code == "FORBIDDEN" || typ == "forbidden":
+		return ErrForbidden
+	case code == "ENTITY_NOT_FOUND" || strings.Contains(strings.ToLower(e.Message), "not found"):
+		return ErrNotFound
+	case code == "INPUT_ERROR" || code == "INVALID_INPUT" || code == "BAD_USER_INPUT" ||
+		code == "GRAPHQL_VALIDATION_FAILED" || typ == "invalid input":
+		return ErrValidation
+	}
+	return nil
+}
+
+// APIError is returned when Linear responds with one or more GraphQL errors
+type APIError struct {
+	StatusCode int
+	Errors     []GraphQLError
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("GraphQL errors: %s", strings.Join(msgs, "; "))
+}
+
+// Unwrap exposes the individual GraphQL errors to errors.Is and errors.As
+func (e *APIError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// UserMessage returns the first user-presentable message Linear attached to
+// the errors, falling back to the raw error messages
+func (e *APIError) UserMessage() string {
+	for _, err := range e.Errors {
+		if err.Extensions != nil && err.Extensions.UserPresentableMessage != "" {
+			return err.Extensions.UserPresentableMessage
+		}
+	}
+
+	msgs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// HTTPError is returned when Linear responds with a non-OK status and no GraphQL errors
+type HTTPError struct {
+	StatusCode int
+	Status     string
+}
+
+// Error implements the error interface
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("received non-OK response: %s", e.Status)
+}
+
+// Is reports whether the status code belongs to the class identified by target
+func (e *HTTPError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	case http.StatusBadRequest:
+		return target == ErrValidation
+	}
+	return false
+}
+
+// NotFoundError is returned when a lookup by a human-readable reference finds nothing
+type NotFoundError struct {
+	Resource string   // Kind of thing that was looked up, e.g. "issue"
+	Ref      string   // Reference that was looked up, e.g. "ENG-123"
+	Options  []string // Optional list of valid references to suggest instead
+}
+
+// Error implements the error interface
+func (e *NotFoundError) Error() string {
+	if len(e.Options) > 0 {
+		return fmt.Sprintf("%s %s does not exist; valid options are: %s", e.Resource, e.Ref, strings.Join(e.Options, ", "))
+	}
+	return fmt.Sprintf("%s %s does not exist", e.Resource, e.Ref)
+}
+
+// Is reports whether target is ErrNotFound
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// AmbiguousError is returned when a human-readable reference matches more than one thing
+type AmbiguousError struct {
+	Resource string   // Kind of thing that was looked up, e.g. "state"
+	Ref      string   // Reference that was looked up, e.g. "done"
+	Matches  []string // Descriptions of everything the reference matched
+}
+
+// Error implements the error interface
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("%s %q is ambiguous; it matches: %s", e.Resource, e.Ref, strings.Join(e.Matches, ", "))
+}
+
+// Is reports whether target is ErrAmbiguous
+func (e *AmbiguousError) Is(target error) bool {
+	return target == ErrAmbiguous
+}
+
+// QueryError is returned when an issue query string cannot be parsed. It
+// points at the offending token so the query can be corrected.
+type QueryError struct {
+	Query   string // The full query that was parsed
+	Offset  int    // Byte offset of the offending token in Query
+	Token   string // The offending token
+	Message string // What is wrong with the token
+}
+
+// Error implements the error interface
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("invalid query at column %d near %q: %s", e.Offset+1, e.Token, e.Message)
+}
+
+// Is reports whether target is ErrValidation
+func (e *QueryError) Is(target error) bool {
+	return target == ErrValidation
+}
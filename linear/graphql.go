@@ -2,18 +2,104 @@ package linear
 
 import (
 	"embed"
+	"fmt"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
 )
 
-//go:embed graphql/*.graphql
+//go:embed graphql/*.graphql graphql/fragments/*.graphql
 var graphqlFS embed.FS
 
-// getGraphQLQuery loads a GraphQL query by filename
+var (
+	fragmentsOnce sync.Once
+	fragments     map[string]string // Fragment name -> definition
+	fragmentsErr  error
+)
+
+var (
+	fragmentDefRe    = regexp.MustCompile(`^fragment\s+(\w+)\s+on\s+(\w+)`)
+	fragmentSpreadRe = regexp.MustCompile(`\.\.\.\s*(\w+)`)
+)
+
+// getGraphQLQuery loads a GraphQL query by filename and appends the
+// definitions of any shared fragments it spreads
 func getGraphQLQuery(filename string) (string, error) {
 	filename = filepath.Base(filename)
 	data, err := graphqlFS.ReadFile("graphql/" + filename)
 	if err != nil {
 		return "", err
 	}
-	return string(data), nil
+	return withFragments(string(data))
+}
+
+// withFragments appends the definitions of every fragment spread in query,
+// including fragments spread by other fragments
+func withFragments(query string) (string, error) {
+	defs, err := loadFragments()
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		included = map[string]bool{}
+		pending  = []string{query}
+		sb       strings.Builder
+	)
+	sb.WriteString(query)
+
+	for len(pending) > 0 {
+		doc := pending[0]
+		pending = pending[1:]
+
+		for _, match := range fragmentSpreadRe.FindAllStringSubmatch(doc, -1) {
+			name := match[1]
+			if name == "on" || included[name] {
+				continue
+			}
+
+			def, ok := defs[name]
+			if !ok {
+				return "", fmt.Errorf("unknown GraphQL fragment %q", name)
+			}
+
+			included[name] = true
+			pending = append(pending, def)
+			sb.WriteString("\n\n")
+			sb.WriteString(def)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// loadFragments reads the shared fragment definitions from graphql/fragments
+func loadFragments() (map[string]string, error) {
+	fragmentsOnce.Do(func() {
+		entries, err := graphqlFS.ReadDir("graphql/fragments")
+		if err != nil {
+			fragmentsErr = err
+			return
+		}
+
+		fragments = make(map[string]string, len(entries))
+		for _, entry := range entries {
+			data, err := graphqlFS.ReadFile("graphql/fragments/" + entry.Name())
+			if err != nil {
+				fragmentsErr = err
+				return
+			}
+
+			def := strings.TrimSpace(string(data))
+			match := fragmentDefRe.FindStringSubmatch(def)
+			if match == nil {
+				fragmentsErr = fmt.Errorf("%s does not define a fragment", entry.Name())
+				return
+			}
+			fragments[match[1]] = def
+		}
+	})
+
+	return fragments, fragmentsErr
 }
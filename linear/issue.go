@@ -1,192 +1,174 @@
 package linear
 
 import (
+	"context"
 	"fmt"
 )
 
-// WorkflowState represents a Linear workflow state
-type WorkflowState struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-}
-
 // Issue represents a Linear issue
 type Issue struct {
-	ID          string         `json:"id"`
-	Identifier  string         `json:"identifier"`
-	Title       string         `json:"title"`
-	Description string         `json:"description,omitempty"`
-	State       *WorkflowState `json:"state,omitempty"`
-	Assignee    *User          `json:"assignee,omitempty"`
-	Project     *Project       `json:"project,omitempty"`
-	Parent      *Issue         `json:"parent,omitempty"`
-	Children    []Issue        `json:"children,omitempty"`
-	Priority    int            `json:"priority"`
-	CreatedAt   string         `json:"createdAt"`
-	UpdatedAt   string         `json:"updatedAt,omitempty"`
-	URL         string         `json:"url,omitempty"`
-	BranchName  string         `json:"branchName,omitempty"`
+	ID          string            `json:"id"`
+	Identifier  string            `json:"identifier"`
+	Title       string            `json:"title"`
+	Description string            `json:"description,omitempty"`
+	Team        *Team             `json:"team,omitempty"`
+	State       *WorkflowState    `json:"state,omitempty"`
+	Assignee    *User             `json:"assignee,omitempty"`
+	Project     *Project          `json:"project,omitempty"`
+	Milestone   *ProjectMilestone `json:"projectMilestone,omitempty"`
+	Cycle       *Cycle            `json:"cycle,omitempty"`
+	Parent      *Issue            `json:"parent,omitempty"`
+	Children    []Issue           `json:"children,omitempty"`
+	Comments    []Comment         `json:"comments,omitempty"`
+	Relations   []IssueRelation   `json:"relations,omitempty"`
+	Attachments []Attachment      `json:"attachments,omitempty"`
+	Labels      Nodes[Label]      `json:"labels,omitempty"`
+	Priority    int               `json:"priority"`
+	Estimate    float64           `json:"estimate,omitempty"`
+	DueDate     string            `json:"dueDate,omitempty"` // Due date as YYYY-MM-DD
+	CreatedAt   string            `json:"createdAt"`
+	UpdatedAt   string            `json:"updatedAt,omitempty"`
+	CompletedAt string            `json:"completedAt,omitempty"` // When the issue was moved to a completed state
+	URL         string            `json:"url,omitempty"`
+	BranchName  string            `json:"branchName,omitempty"`
+}
+
+// issuePayload is the result of an issue mutation
+type issuePayload struct {
+	Success bool   `json:"success"`
+	Issue   *Issue `json:"issue"`
 }
 
 // GetTeamIssuesOptions contains optional parameters for getting team issues
 type GetTeamIssuesOptions struct {
-	First int // Number of issues to fetch (max 100)
+	First int    // Number of issues to fetch (max 100)
+	After string // Cursor to start fetching after
+}
+
+// GetTeamIssues returns a page of issues for a specific team
+func (c *Client) GetTeamIssues(teamID string, opts *GetTeamIssuesOptions) (*Page[Issue], error) {
+	return c.GetTeamIssuesContext(context.Background(), teamID, opts)
 }
 
-// GetTeamIssues returns issues for a specific team
-func (c *Client) GetTeamIssues(teamID string, opts *GetTeamIssuesOptions) ([]Issue, error) {
+// GetTeamIssuesContext is like GetTeamIssues but honors ctx for cancellation and deadlines
+func (c *Client) GetTeamIssuesContext(ctx context.Context, teamID string, opts *GetTeamIssuesOptions) (*Page[Issue], error) {
 	variables := map[string]interface{}{
 		"teamId": teamID,
 	}
 
-	first := 50
-	if opts != nil && opts.First > 0 && opts.First <= 100 {
-		first = opts.First
+	if opts == nil {
+		opts = &GetTeamIssuesOptions{}
 	}
-	variables["first"] = first
+	paginationVariables(variables, opts.First, opts.After)
 
-	query, err := getGraphQLQuery("get_team_issues.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load GetTeamIssues query: %w", err)
-	}
-
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		Team *struct {
+			Issues Page[Issue] `json:"issues"`
+		} `json:"team"`
+	}](ctx, c, "get_team_issues.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	teamData, ok := resp.Data["team"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid team data format")
-	}
-
-	issuesData, ok := teamData["issues"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issues data format")
+	if data.Team == nil {
+		return nil, &NotFoundError{Resource: "team", Ref: teamID}
 	}
 
-	nodesData, ok := issuesData["nodes"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issues nodes format")
-	}
-
-	issues := make([]Issue, 0, len(nodesData))
-	for _, node := range nodesData {
-		nodeMap, ok := node.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("invalid issue node format")
-		}
-
-		issue := Issue{
-			ID:          safeGetString(nodeMap, "id"),
-			Identifier:  safeGetString(nodeMap, "identifier"),
-			Title:       safeGetString(nodeMap, "title"),
-			Description: safeGetString(nodeMap, "description"),
-			Priority:    safeGetInt(nodeMap, "priority"),
-			CreatedAt:   safeGetString(nodeMap, "createdAt"),
-			UpdatedAt:   safeGetString(nodeMap, "updatedAt"),
-			BranchName:  safeGetString(nodeMap, "branchName"),
-		}
-
-		if stateMap, ok := nodeMap["state"].(map[string]interface{}); ok {
-			issue.State = &WorkflowState{
-				ID:   safeGetString(stateMap, "id"),
-				Name: safeGetString(stateMap, "name"),
-			}
-		}
-
-		if assigneeMap, ok := nodeMap["assignee"].(map[string]interface{}); ok {
-			issue.Assignee = &User{
-				ID:    safeGetString(assigneeMap, "id"),
-				Name:  safeGetString(assigneeMap, "name"),
-				Email: safeGetString(assigneeMap, "email"),
-			}
-		}
+	return &data.Team.Issues, nil
+}
 
-		issues = append(issues, issue)
-	}
+// GetAllTeamIssues returns up to limit issues for a team, following page
+// cursors as needed. A limit of 0 or less returns every issue in the team.
+func (c *Client) GetAllTeamIssues(teamID string, limit int) ([]Issue, error) {
+	return c.GetAllTeamIssuesContext(context.Background(), teamID, limit)
+}
 
-	return issues, nil
+// GetAllTeamIssuesContext is like GetAllTeamIssues but honors ctx for cancellation and deadlines
+func (c *Client) GetAllTeamIssuesContext(ctx context.Context, teamID string, limit int) ([]Issue, error) {
+	return CollectPages(limit, func(after string) (*Page[Issue], error) {
+		return c.GetTeamIssuesContext(ctx, teamID, &GetTeamIssuesOptions{First: maxPageSize, After: after})
+	})
 }
 
 // GetIssueOptions contains optional parameters for getting issue details
 type GetIssueOptions struct {
-	IncludeChildren bool // Whether to include children (sub-issues) in the response
-	ChildrenFirst   int  // Number of children to fetch (max 100)
+	IncludeChildren    bool // Whether to include children (sub-issues) in the response
+	ChildrenFirst      int  // Number of children to fetch (max 100)
+	IncludeComments    bool // Whether to include comments in the response
+	CommentsFirst      int  // Number of comments to fetch (max 100)
+	IncludeRelations   bool // Whether to include blocks, blocked-by, duplicate and related issues in the response
+	IncludeAttachments bool // Whether to include attachments, such as linked pull requests and uploaded files, in the response
 }
 
 // GetIssue returns details of a specific issue by ID
 func (c *Client) GetIssue(issueID string, opts *GetIssueOptions) (*Issue, error) {
+	return c.GetIssueContext(context.Background(), issueID, opts)
+}
+
+// GetIssueContext is like GetIssue but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueContext(ctx context.Context, issueID string, opts *GetIssueOptions) (*Issue, error) {
 	variables := map[string]interface{}{
 		"id": issueID,
 	}
 
-	query, err := getGraphQLQuery("get_issue.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load GetIssue query: %w", err)
-	}
-
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		Issue *Issue `json:"issue"`
+	}](ctx, c, "get_issue.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	issueData, ok := resp.Data["issue"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issue data format")
+	if data.Issue == nil {
+		return nil, &NotFoundError{Resource: "issue", Ref: issueID}
 	}
+	issue := data.Issue
 
-	issue := &Issue{
-		ID:          safeGetString(issueData, "id"),
-		Identifier:  safeGetString(issueData, "identifier"),
-		Title:       safeGetString(issueData, "title"),
-		Description: safeGetString(issueData, "description"),
-		Priority:    safeGetInt(issueData, "priority"),
-		CreatedAt:   safeGetString(issueData, "createdAt"),
-		UpdatedAt:   safeGetString(issueData, "updatedAt"),
-		URL:         safeGetString(issueData, "url"),
-		BranchName:  safeGetString(issueData, "branchName"),
-	}
+	// If IncludeChildren is true, fetch and populate the children
+	if opts != nil && opts.IncludeChildren {
+		childrenOpts := &GetIssueChildrenOptions{
+			First: opts.ChildrenFirst,
+		}
 
-	if stateMap, ok := issueData["state"].(map[string]interface{}); ok {
-		issue.State = &WorkflowState{
-			ID:   safeGetString(stateMap, "id"),
-			Name: safeGetString(stateMap, "name"),
+		children, err := c.GetIssueChildrenContext(ctx, issueID, childrenOpts)
+		if err != nil {
+			return issue, fmt.Errorf("failed to load children: %w", err)
 		}
+
+		issue.Children = children.Nodes
 	}
 
-	if assigneeMap, ok := issueData["assignee"].(map[string]interface{}); ok {
-		issue.Assignee = &User{
-			ID:    safeGetString(assigneeMap, "id"),
-			Name:  safeGetString(assigneeMap, "name"),
-			Email: safeGetString(assigneeMap, "email"),
+	// If IncludeComments is true, fetch and populate the comments
+	if opts != nil && opts.IncludeComments {
+		commentsOpts := &GetIssueCommentsOptions{
+			First: opts.CommentsFirst,
 		}
-	}
 
-	if parentMap, ok := issueData["parent"].(map[string]interface{}); ok {
-		issue.Parent = &Issue{
-			ID:         safeGetString(parentMap, "id"),
-			Identifier: safeGetString(parentMap, "identifier"),
-			Title:      safeGetString(parentMap, "title"),
+		comments, err := c.GetIssueCommentsContext(ctx, issueID, commentsOpts)
+		if err != nil {
+			return issue, fmt.Errorf("failed to load comments: %w", err)
 		}
+
+		issue.Comments = comments.Nodes
 	}
 
-	// If IncludeChildren is true, fetch and populate the children
-	if opts != nil && opts.IncludeChildren {
-		childrenOpts := &GetIssueChildrenOptions{
-			First: 50, // Default to 50 children
+	// If IncludeRelations is true, fetch and populate the relations
+	if opts != nil && opts.IncludeRelations {
+		relations, err := c.GetIssueRelationsContext(ctx, issueID)
+		if err != nil {
+			return issue, fmt.Errorf("failed to load relations: %w", err)
 		}
 
-		if opts.ChildrenFirst > 0 && opts.ChildrenFirst <= 100 {
-			childrenOpts.First = opts.ChildrenFirst
-		}
+		issue.Relations = relations
+	}
 
-		children, err := c.GetIssueChildren(issueID, childrenOpts)
+	// If IncludeAttachments is true, fetch and populate the attachments
+	if opts != nil && opts.IncludeAttachments {
+		attachments, err := c.GetIssueAttachmentsContext(ctx, issueID)
 		if err != nil {
-			return issue, fmt.Errorf("failed to load children: %w", err)
+			return issue, fmt.Errorf("failed to load attachments: %w", err)
 		}
 
-		issue.Children = children
+		issue.Attachments = attachments
 	}
 
 	return issue, nil
@@ -194,304 +176,227 @@ func (c *Client) GetIssue(issueID string, opts *GetIssueOptions) (*Issue, error)
 
 // CreateIssueInput represents input for creating a new issue
 type CreateIssueInput struct {
-	TeamID      string `json:"teamId"`
-	Title       string `json:"title"`
-	Description string `json:"description,omitempty"`
-	Priority    int    `json:"priority,omitempty"`
-	StateID     string `json:"stateId,omitempty"`
-	AssigneeID  string `json:"assigneeId,omitempty"`
-	ProjectID   string `json:"projectId,omitempty"` // Optional project ID to associate the issue with
-	ParentID    string `json:"parentId,omitempty"`  // Optional parent issue ID to create a sub-issue
+	TeamID      string   `json:"teamId"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Priority    int      `json:"priority,omitempty"`
+	StateID     string   `json:"stateId,omitempty"`
+	AssigneeID  string   `json:"assigneeId,omitempty"`
+	ProjectID   string   `json:"projectId,omitempty"`          // Optional project ID to associate the issue with
+	MilestoneID string   `json:"projectMilestoneId,omitempty"` // Optional milestone ID within the issue's project
+	ParentID    string   `json:"parentId,omitempty"`           // Optional parent issue ID to create a sub-issue
+	LabelIDs    []string `json:"labelIds,omitempty"`           // Optional label IDs to apply to the issue
+	CycleID     string   `json:"cycleId,omitempty"`            // Optional cycle ID, number, or "current"/"next" relative to the team
 }
 
 // CreateIssue creates a new issue in Linear
 func (c *Client) CreateIssue(input CreateIssueInput) (*Issue, error) {
-	// Build the input object
-	variables := map[string]interface{}{
-		"input": map[string]interface{}{
-			"teamId":      input.TeamID,
-			"title":       input.Title,
-			"description": input.Description,
-		},
-	}
-
-	// Add optional fields to the input object
-	inputObj := variables["input"].(map[string]interface{})
-
-	if input.Priority > 0 {
-		inputObj["priority"] = input.Priority
-	}
+	return c.CreateIssueContext(context.Background(), input)
+}
 
-	if input.StateID != "" {
-		inputObj["stateId"] = input.StateID
+// CreateIssueContext is like CreateIssue but honors ctx for cancellation and deadlines
+func (c *Client) CreateIssueContext(ctx context.Context, input CreateIssueInput) (*Issue, error) {
+	inputObj, err := c.createIssueInput(ctx, input)
+	if err != nil {
+		return nil, err
 	}
 
-	if input.AssigneeID != "" {
-		inputObj["assigneeId"] = input.AssigneeID
+	variables := map[string]interface{}{
+		"input": inputObj,
 	}
 
-	if input.ProjectID != "" {
-		inputObj["projectId"] = input.ProjectID
+	data, err := queryInto[struct {
+		IssueCreate issuePayload `json:"issueCreate"`
+	}](ctx, c, "create_issue.graphql", variables)
+	if err != nil {
+		return nil, err
 	}
 
-	if input.ParentID != "" {
-		inputObj["parentId"] = input.ParentID
+	if !data.IssueCreate.Success || data.IssueCreate.Issue == nil {
+		return nil, fmt.Errorf("issue creation was not successful")
 	}
 
-	query, err := getGraphQLQuery("create_issue.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load CreateIssue query: %w", err)
-	}
+	return data.IssueCreate.Issue, nil
+}
 
-	resp, err := c.ExecuteGraphQL(query, variables)
-	if err != nil {
-		return nil, err
+// createIssueInput builds the IssueCreateInput object for input, resolving
+// relative cycle references against the issue's team
+func (c *Client) createIssueInput(ctx context.Context, input CreateIssueInput) (map[string]interface{}, error) {
+	// Build the input object
+	inputObj := map[string]interface{}{
+		"teamId":      input.TeamID,
+		"title":       input.Title,
+		"description": input.Description,
 	}
 
-	issueCreateData, ok := resp.Data["issueCreate"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issueCreate data format")
+	// Add optional fields to the input object
+	if input.Priority > 0 {
+		inputObj["priority"] = input.Priority
 	}
 
-	success, ok := issueCreateData["success"].(bool)
-	if !ok || !success {
-		return nil, fmt.Errorf("issue creation was not successful")
+	if input.StateID != "" {
+		inputObj["stateId"] = input.StateID
 	}
 
-	issueData, ok := issueCreateData["issue"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issue data format")
+	if input.AssigneeID != "" {
+		inputObj["assigneeId"] = input.AssigneeID
 	}
 
-	issue := &Issue{
-		ID:          safeGetString(issueData, "id"),
-		Identifier:  safeGetString(issueData, "identifier"),
-		Title:       safeGetString(issueData, "title"),
-		Description: safeGetString(issueData, "description"),
-		Priority:    safeGetInt(issueData, "priority"),
-		URL:         safeGetString(issueData, "url"),
-		BranchName:  safeGetString(issueData, "branchName"),
+	if input.ProjectID != "" {
+		inputObj["projectId"] = input.ProjectID
 	}
 
-	if stateMap, ok := issueData["state"].(map[string]interface{}); ok {
-		issue.State = &WorkflowState{
-			ID:   safeGetString(stateMap, "id"),
-			Name: safeGetString(stateMap, "name"),
-		}
+	if input.MilestoneID != "" {
+		inputObj["projectMilestoneId"] = input.MilestoneID
 	}
 
-	if assigneeMap, ok := issueData["assignee"].(map[string]interface{}); ok {
-		issue.Assignee = &User{
-			ID:   safeGetString(assigneeMap, "id"),
-			Name: safeGetString(assigneeMap, "name"),
-		}
+	if input.ParentID != "" {
+		inputObj["parentId"] = input.ParentID
 	}
 
-	if projectMap, ok := issueData["project"].(map[string]interface{}); ok {
-		issue.Project = &Project{
-			ID:   safeGetString(projectMap, "id"),
-			Name: safeGetString(projectMap, "name"),
-		}
+	if len(input.LabelIDs) > 0 {
+		inputObj["labelIds"] = input.LabelIDs
 	}
 
-	if parentMap, ok := issueData["parent"].(map[string]interface{}); ok {
-		issue.Parent = &Issue{
-			ID:         safeGetString(parentMap, "id"),
-			Identifier: safeGetString(parentMap, "identifier"),
-			Title:      safeGetString(parentMap, "title"),
+	if input.CycleID != "" {
+		cycleID, err := c.cycleIDFor(ctx, input.TeamID, input.CycleID)
+		if err != nil {
+			return nil, err
 		}
+		inputObj["cycleId"] = cycleID
 	}
 
-	return issue, nil
+	return inputObj, nil
 }
 
 // UpdateIssueInput represents input for updating an issue
 type UpdateIssueInput struct {
-	Title       *string `json:"title,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Priority    *int    `json:"priority,omitempty"`
-	StateID     *string `json:"stateId,omitempty"`
-	AssigneeID  *string `json:"assigneeId,omitempty"`
-	ProjectID   *string `json:"projectId,omitempty"` // Optional project ID to associate the issue with
-	ParentID    *string `json:"parentId,omitempty"`  // Optional parent issue ID to update parent-child relationship
+	Title       *string  `json:"title,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Priority    *int     `json:"priority,omitempty"`
+	StateID     *string  `json:"stateId,omitempty"`
+	AssigneeID  *string  `json:"assigneeId,omitempty"`         // An empty string unassigns the issue
+	ProjectID   *string  `json:"projectId,omitempty"`          // Optional project ID to associate the issue with
+	MilestoneID *string  `json:"projectMilestoneId,omitempty"` // Milestone ID within the issue's project; an empty string removes the issue from its milestone
+	ParentID    *string  `json:"parentId,omitempty"`           // Optional parent issue ID to update parent-child relationship
+	LabelIDs    []string `json:"labelIds,omitempty"`           // Replaces all labels when non-nil; an empty slice clears them
+	CycleID     *string  `json:"cycleId,omitempty"`            // Cycle ID, number, or "current"/"next"; an empty string removes the issue from its cycle
 }
 
 // GetIssueChildrenOptions contains optional parameters for getting issue children
 type GetIssueChildrenOptions struct {
-	First int // Number of sub-issues to fetch (max 100)
+	First int    // Number of sub-issues to fetch (max 100)
+	After string // Cursor to start fetching after
+}
+
+// GetIssueChildren returns a page of child issues (sub-issues) for a specific issue
+func (c *Client) GetIssueChildren(issueID string, opts *GetIssueChildrenOptions) (*Page[Issue], error) {
+	return c.GetIssueChildrenContext(context.Background(), issueID, opts)
 }
 
-// GetIssueChildren returns child issues (sub-issues) for a specific issue
-func (c *Client) GetIssueChildren(issueID string, opts *GetIssueChildrenOptions) ([]Issue, error) {
+// GetIssueChildrenContext is like GetIssueChildren but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueChildrenContext(ctx context.Context, issueID string, opts *GetIssueChildrenOptions) (*Page[Issue], error) {
 	variables := map[string]interface{}{
 		"id": issueID,
 	}
 
-	first := 50
-	if opts != nil && opts.First > 0 && opts.First <= 100 {
-		first = opts.First
-	}
-	variables["first"] = first
-
-	query, err := getGraphQLQuery("get_issue_children.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load GetIssueChildren query: %w", err)
+	if opts == nil {
+		opts = &GetIssueChildrenOptions{}
 	}
+	paginationVariables(variables, opts.First, opts.After)
 
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		Issue *struct {
+			Children Page[Issue] `json:"children"`
+		} `json:"issue"`
+	}](ctx, c, "get_issue_children.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	issueData, ok := resp.Data["issue"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issue data format")
-	}
-
-	childrenData, ok := issueData["children"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid children data format")
+	if data.Issue == nil {
+		return nil, &NotFoundError{Resource: "issue", Ref: issueID}
 	}
 
-	nodesData, ok := childrenData["nodes"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid children nodes format")
-	}
-
-	children := make([]Issue, 0, len(nodesData))
-	for _, node := range nodesData {
-		nodeMap, ok := node.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("invalid child node format")
-		}
-
-		child := Issue{
-			ID:          safeGetString(nodeMap, "id"),
-			Identifier:  safeGetString(nodeMap, "identifier"),
-			Title:       safeGetString(nodeMap, "title"),
-			Description: safeGetString(nodeMap, "description"),
-			Priority:    safeGetInt(nodeMap, "priority"),
-			CreatedAt:   safeGetString(nodeMap, "createdAt"),
-			UpdatedAt:   safeGetString(nodeMap, "updatedAt"),
-			BranchName:  safeGetString(nodeMap, "branchName"),
-		}
-
-		if stateMap, ok := nodeMap["state"].(map[string]interface{}); ok {
-			child.State = &WorkflowState{
-				ID:   safeGetString(stateMap, "id"),
-				Name: safeGetString(stateMap, "name"),
-			}
-		}
-
-		if assigneeMap, ok := nodeMap["assignee"].(map[string]interface{}); ok {
-			child.Assignee = &User{
-				ID:    safeGetString(assigneeMap, "id"),
-				Name:  safeGetString(assigneeMap, "name"),
-				Email: safeGetString(assigneeMap, "email"),
-			}
-		}
+	return &data.Issue.Children, nil
+}
 
-		children = append(children, child)
-	}
+// GetAllIssueChildren returns up to limit sub-issues of an issue, following
+// page cursors as needed. A limit of 0 or less returns every sub-issue.
+func (c *Client) GetAllIssueChildren(issueID string, limit int) ([]Issue, error) {
+	return c.GetAllIssueChildrenContext(context.Background(), issueID, limit)
+}
 
-	return children, nil
+// GetAllIssueChildrenContext is like GetAllIssueChildren but honors ctx for cancellation and deadlines
+func (c *Client) GetAllIssueChildrenContext(ctx context.Context, issueID string, limit int) ([]Issue, error) {
+	return CollectPages(limit, func(after string) (*Page[Issue], error) {
+		return c.GetIssueChildrenContext(ctx, issueID, &GetIssueChildrenOptions{First: maxPageSize, After: after})
+	})
 }
 
 // GetIssueByIdentifier returns an issue by its identifier (e.g., "PE-123")
 func (c *Client) GetIssueByIdentifier(identifier string) (*Issue, error) {
+	return c.GetIssueByIdentifierContext(context.Background(), identifier)
+}
+
+// GetIssueByIdentifierContext is like GetIssueByIdentifier but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueByIdentifierContext(ctx context.Context, identifier string) (*Issue, error) {
 	variables := map[string]interface{}{
 		"identifier": identifier,
 	}
 
-	query, err := getGraphQLQuery("search_issues.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load SearchIssuesByIdentifier query: %w", err)
-	}
-
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		SearchIssues Page[Issue] `json:"searchIssues"`
+	}](ctx, c, "search_issues_by_identifier.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	searchData, ok := resp.Data["searchIssues"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid searchIssues data format")
-	}
-
-	nodesData, ok := searchData["nodes"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid searchIssues nodes format")
+	for i := range data.SearchIssues.Nodes {
+		if data.SearchIssues.Nodes[i].Identifier == identifier {
+			return &data.SearchIssues.Nodes[i], nil
+		}
 	}
 
-	if len(nodesData) == 0 {
-		return nil, fmt.Errorf("no issues found matching identifier: %s", identifier)
-	}
+	return nil, &NotFoundError{Resource: "issue", Ref: identifier}
+}
 
-	for _, node := range nodesData {
-		nodeMap, ok := node.(map[string]interface{})
-		if !ok {
-			continue
-		}
+// UpdateIssue updates an existing issue in Linear
+func (c *Client) UpdateIssue(issueID string, input UpdateIssueInput) (*Issue, error) {
+	return c.UpdateIssueContext(context.Background(), issueID, input)
+}
 
-		issueIdentifier := safeGetString(nodeMap, "identifier")
-		if issueIdentifier == identifier {
-			return mapNodeToIssue(nodeMap)
-		}
+// UpdateIssueContext is like UpdateIssue but honors ctx for cancellation and deadlines
+func (c *Client) UpdateIssueContext(ctx context.Context, issueID string, input UpdateIssueInput) (*Issue, error) {
+	inputObj, err := c.updateIssueInput(ctx, issueID, input)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("no issue found with exact identifier: %s", identifier)
-}
-
-// Helper function to map a node to an Issue
-func mapNodeToIssue(nodeMap map[string]interface{}) (*Issue, error) {
-	issue := &Issue{
-		ID:          safeGetString(nodeMap, "id"),
-		Identifier:  safeGetString(nodeMap, "identifier"),
-		Title:       safeGetString(nodeMap, "title"),
-		Description: safeGetString(nodeMap, "description"),
-		Priority:    safeGetInt(nodeMap, "priority"),
-		CreatedAt:   safeGetString(nodeMap, "createdAt"),
-		UpdatedAt:   safeGetString(nodeMap, "updatedAt"),
-		URL:         safeGetString(nodeMap, "url"),
-		BranchName:  safeGetString(nodeMap, "branchName"),
-	}
-
-	if stateMap, ok := nodeMap["state"].(map[string]interface{}); ok {
-		issue.State = &WorkflowState{
-			ID:   safeGetString(stateMap, "id"),
-			Name: safeGetString(stateMap, "name"),
-		}
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"input": inputObj,
 	}
 
-	if assigneeMap, ok := nodeMap["assignee"].(map[string]interface{}); ok {
-		issue.Assignee = &User{
-			ID:    safeGetString(assigneeMap, "id"),
-			Name:  safeGetString(assigneeMap, "name"),
-			Email: safeGetString(assigneeMap, "email"),
-		}
+	data, err := queryInto[struct {
+		IssueUpdate issuePayload `json:"issueUpdate"`
+	}](ctx, c, "update_issue.graphql", variables)
+	if err != nil {
+		return nil, err
 	}
 
-	if parentMap, ok := nodeMap["parent"].(map[string]interface{}); ok {
-		issue.Parent = &Issue{
-			ID:         safeGetString(parentMap, "id"),
-			Identifier: safeGetString(parentMap, "identifier"),
-			Title:      safeGetString(parentMap, "title"),
-		}
+	if !data.IssueUpdate.Success || data.IssueUpdate.Issue == nil {
+		return nil, fmt.Errorf("issue update was not successful")
 	}
 
-	return issue, nil
+	return data.IssueUpdate.Issue, nil
 }
 
-// UpdateIssue updates an existing issue in Linear
-func (c *Client) UpdateIssue(issueID string, input UpdateIssueInput) (*Issue, error) {
-	// Build the input object
-	variables := map[string]interface{}{
-		"id":    issueID,
-		"input": map[string]interface{}{},
-	}
-
+// updateIssueInput builds the IssueUpdateInput object for input, resolving
+// relative cycle references against the issue's team
+func (c *Client) updateIssueInput(ctx context.Context, issueID string, input UpdateIssueInput) (map[string]interface{}, error) {
 	// Add optional fields to the input object
-	inputObj := variables["input"].(map[string]interface{})
+	inputObj := map[string]interface{}{}
 
 	if input.Title != nil {
 		inputObj["title"] = *input.Title
@@ -510,80 +415,64 @@ func (c *Client) UpdateIssue(issueID string, input UpdateIssueInput) (*Issue, er
 	}
 
 	if input.AssigneeID != nil {
-		inputObj["assigneeId"] = *input.AssigneeID
+		if *input.AssigneeID == "" {
+			inputObj["assigneeId"] = nil
+		} else {
+			inputObj["assigneeId"] = *input.AssigneeID
+		}
 	}
 
 	if input.ProjectID != nil {
 		inputObj["projectId"] = *input.ProjectID
 	}
 
-	if input.ParentID != nil {
-		inputObj["parentId"] = *input.ParentID
-	}
-
-	query, err := getGraphQLQuery("update_issue.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load UpdateIssue query: %w", err)
-	}
-
-	resp, err := c.ExecuteGraphQL(query, variables)
-	if err != nil {
-		return nil, err
+	if input.MilestoneID != nil {
+		if *input.MilestoneID == "" {
+			inputObj["projectMilestoneId"] = nil
+		} else {
+			inputObj["projectMilestoneId"] = *input.MilestoneID
+		}
 	}
 
-	issueUpdateData, ok := resp.Data["issueUpdate"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issueUpdate data format")
+	if input.ParentID != nil {
+		inputObj["parentId"] = *input.ParentID
 	}
 
-	success, ok := issueUpdateData["success"].(bool)
-	if !ok || !success {
-		return nil, fmt.Errorf("issue update was not successful")
+	if input.LabelIDs != nil {
+		inputObj["labelIds"] = input.LabelIDs
 	}
 
-	issueData, ok := issueUpdateData["issue"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid issue data format")
+	if input.CycleID != nil {
+		cycleID, err := c.updateCycleID(ctx, issueID, *input.CycleID)
+		if err != nil {
+			return nil, err
+		}
+		inputObj["cycleId"] = cycleID
 	}
 
-	issue := &Issue{
-		ID:          safeGetString(issueData, "id"),
-		Identifier:  safeGetString(issueData, "identifier"),
-		Title:       safeGetString(issueData, "title"),
-		Description: safeGetString(issueData, "description"),
-		Priority:    safeGetInt(issueData, "priority"),
-		URL:         safeGetString(issueData, "url"),
-		BranchName:  safeGetString(issueData, "branchName"),
-	}
+	return inputObj, nil
+}
 
-	if stateMap, ok := issueData["state"].(map[string]interface{}); ok {
-		issue.State = &WorkflowState{
-			ID:   safeGetString(stateMap, "id"),
-			Name: safeGetString(stateMap, "name"),
-		}
+// updateCycleID resolves the cycle an issue is being moved to. Relative
+// references are resolved against the issue's team, and an empty reference
+// becomes null to remove the issue from its cycle.
+func (c *Client) updateCycleID(ctx context.Context, issueID, ref string) (interface{}, error) {
+	if ref == "" {
+		return nil, nil
 	}
 
-	if assigneeMap, ok := issueData["assignee"].(map[string]interface{}); ok {
-		issue.Assignee = &User{
-			ID:   safeGetString(assigneeMap, "id"),
-			Name: safeGetString(assigneeMap, "name"),
-		}
+	if !isRelativeCycleRef(ref) {
+		return ref, nil
 	}
 
-	if projectMap, ok := issueData["project"].(map[string]interface{}); ok {
-		issue.Project = &Project{
-			ID:   safeGetString(projectMap, "id"),
-			Name: safeGetString(projectMap, "name"),
-		}
+	issue, err := c.GetIssueContext(ctx, issueID, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if parentMap, ok := issueData["parent"].(map[string]interface{}); ok {
-		issue.Parent = &Issue{
-			ID:         safeGetString(parentMap, "id"),
-			Identifier: safeGetString(parentMap, "identifier"),
-			Title:      safeGetString(parentMap, "title"),
-		}
+	if issue.Team == nil {
+		return nil, fmt.Errorf("could not determine the team of issue %s", issueID)
 	}
 
-	return issue, nil
+	return c.cycleIDFor(ctx, issue.Team.ID, ref)
 }
@@ -0,0 +1,205 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// defaultTreeDepth and defaultTreeNodes bound GetIssueTree when
+// GetIssueTreeOptions leaves them unset
+const (
+	defaultTreeDepth = 3
+	defaultTreeNodes = 250
+)
+
+// IssueTree is an issue together with its sub-issues, loaded recursively
+type IssueTree struct {
+	Issue     *Issue       `json:"issue"`
+	Children  []*IssueTree `json:"children,omitempty"`
+	Rollup    *IssueRollup `json:"rollup,omitempty"`    // Totals over all loaded descendants; nil for leaves
+	Truncated bool         `json:"truncated,omitempty"` // Set on the root when MaxNodes stopped the tree from loading fully
+}
+
+// IssueRollup summarizes the descendants of an issue
+type IssueRollup struct {
+	Total           int            `json:"total"`           // Number of descendants
+	States          map[string]int `json:"states"`          // Number of descendants per state type (e.g. started)
+	Estimate        float64        `json:"estimate"`        // Sum of descendant estimates
+	PercentComplete float64        `json:"percentComplete"` // Share of non-canceled descendants that are completed
+}
+
+// GetIssueTreeOptions contains optional parameters for loading an issue tree
+type GetIssueTreeOptions struct {
+	MaxDepth int // Number of sub-issue levels to load below the issue (default 3)
+	MaxNodes int // Maximum number of sub-issues to load in total (default 250)
+}
+
+// GetIssueTree loads an issue and its sub-issues down to MaxDepth levels,
+// fetching each level in one batched query, and rolls up progress at every level
+func (c *Client) GetIssueTree(issueID string, opts *GetIssueTreeOptions) (*IssueTree, error) {
+	return c.GetIssueTreeContext(context.Background(), issueID, opts)
+}
+
+// GetIssueTreeContext is like GetIssueTree but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueTreeContext(ctx context.Context, issueID string, opts *GetIssueTreeOptions) (*IssueTree, error) {
+	maxDepth, maxNodes := defaultTreeDepth, defaultTreeNodes
+	if opts != nil && opts.MaxDepth > 0 {
+		maxDepth = opts.MaxDepth
+	}
+	if opts != nil && opts.MaxNodes > 0 {
+		maxNodes = opts.MaxNodes
+	}
+
+	root, err := c.GetIssueContext(ctx, issueID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &IssueTree{Issue: root}
+	level := map[string]*IssueTree{root.ID: tree}
+	loaded := 0
+
+	for depth := 0; depth < maxDepth && len(level) > 0; depth++ {
+		parentIDs := make([]string, 0, len(level))
+		for id := range level {
+			parentIDs = append(parentIDs, id)
+		}
+
+		children, err := c.getIssuesByParents(ctx, parentIDs, maxNodes-loaded+1)
+		if err != nil {
+			return nil, err
+		}
+
+		// Fetching one extra issue tells us whether the budget cut the tree short
+		if loaded+len(children) > maxNodes {
+			children = children[:maxNodes-loaded]
+			tree.Truncated = true
+		}
+		loaded += len(children)
+
+		next := map[string]*IssueTree{}
+		for i := range children {
+			child := &children[i]
+			if child.Parent == nil || level[child.Parent.ID] == nil {
+				continue
+			}
+
+			node := &IssueTree{Issue: child}
+			parent := level[child.Parent.ID]
+			parent.Children = append(parent.Children, node)
+			next[child.ID] = node
+		}
+
+		if tree.Truncated {
+			break
+		}
+		level = next
+	}
+
+	tree.rollup()
+	return tree, nil
+}
+
+// getIssuesByParents returns up to limit sub-issues of any of parentIDs
+func (c *Client) getIssuesByParents(ctx context.Context, parentIDs []string, limit int) ([]Issue, error) {
+	return CollectPages(limit, func(after string) (*Page[Issue], error) {
+		variables := map[string]interface{}{
+			"filter": map[string]interface{}{
+				"parent": idIn(parentIDs),
+			},
+		}
+		paginationVariables(variables, maxPageSize, after)
+
+		data, err := queryInto[struct {
+			Issues Page[Issue] `json:"issues"`
+		}](ctx, c, "filter_issues.graphql", variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return &data.Issues, nil
+	})
+}
+
+// rollup computes the rollups of t and its descendants, returning the
+// totals over t's subtree including t itself
+func (t *IssueTree) rollup() IssueRollup {
+	descendants := IssueRollup{States: map[string]int{}}
+	for _, child := range t.Children {
+		totals := child.rollup()
+		descendants.Total += totals.Total
+		descendants.Estimate += totals.Estimate
+		for state, count := range totals.States {
+			descendants.States[state] += count
+		}
+	}
+
+	subtree := IssueRollup{
+		Total:    descendants.Total + 1,
+		Estimate: descendants.Estimate + t.Issue.Estimate,
+		States:   map[string]int{},
+	}
+	for state, count := range descendants.States {
+		subtree.States[state] = count
+	}
+	if t.Issue.State != nil {
+		subtree.States[t.Issue.State.Type]++
+	}
+
+	if len(t.Children) > 0 {
+		if counted := descendants.Total - descendants.States[StateTypeCanceled]; counted > 0 {
+			completed := descendants.States[StateTypeCompleted]
+			descendants.PercentComplete = math.Round(float64(completed)/float64(counted)*1000) / 10
+		}
+		t.Rollup = &descendants
+	}
+
+	return subtree
+}
+
+// Outline renders the tree as an indented outline, one issue per line, e.g.
+//
+//	ENG-1 Launch checkout [In Progress] (2/3 done, 67%)
+//	  ENG-2 Payment form [Done]
+func (t *IssueTree) Outline() string {
+	var b strings.Builder
+	t.writeOutline(&b, 0)
+	if t.Truncated {
+		b.WriteString("… more sub-issues were not loaded; narrow the depth or raise the node limit\n")
+	}
+	return b.String()
+}
+
+// writeOutline writes t and its descendants at the given indentation level
+func (t *IssueTree) writeOutline(b *strings.Builder, indent int) {
+	issue := t.Issue
+	b.WriteString(strings.Repeat("  ", indent))
+	b.WriteString(issue.Identifier)
+	if issue.Title != "" {
+		b.WriteString(" " + issue.Title)
+	}
+	if issue.State != nil {
+		fmt.Fprintf(b, " [%s]", issue.State.Name)
+	}
+	if issue.Assignee != nil {
+		fmt.Fprintf(b, " @%s", issue.Assignee.DisplayName)
+	}
+	if issue.Estimate > 0 {
+		fmt.Fprintf(b, " est %g", issue.Estimate)
+	}
+	if t.Rollup != nil {
+		counted := t.Rollup.Total - t.Rollup.States[StateTypeCanceled]
+		fmt.Fprintf(b, " (%d/%d done, %g%%", t.Rollup.States[StateTypeCompleted], counted, t.Rollup.PercentComplete)
+		if t.Rollup.Estimate > 0 {
+			fmt.Fprintf(b, ", est %g total", t.Rollup.Estimate)
+		}
+		b.WriteString(")")
+	}
+	b.WriteString("\n")
+
+	for _, child := range t.Children {
+		child.writeOutline(b, indent+1)
+	}
+}
@@ -0,0 +1,111 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetIssueTree(t *testing.T) {
+	issue := func(id, parentID, stateType string, estimate float64) map[string]interface{} {
+		node := map[string]interface{}{
+			"id":         id,
+			"identifier": "ENG-" + id[1:],
+			"title":      "Issue " + id[1:],
+			"estimate":   estimate,
+			"state":      map[string]interface{}{"name": stateType, "type": stateType},
+		}
+		if parentID != "" {
+			node["parent"] = map[string]interface{}{"id": parentID}
+		}
+		return node
+	}
+
+	// ENG-1 has ENG-2 (with ENG-4 and ENG-5 below it) and ENG-3 (canceled)
+	children := map[string][]map[string]interface{}{
+		"i1": {issue("i2", "i1", StateTypeStarted, 3), issue("i3", "i1", StateTypeCanceled, 0)},
+		"i2": {issue("i4", "i2", StateTypeCompleted, 1), issue("i5", "i2", StateTypeUnstarted, 2)},
+	}
+
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		var data map[string]interface{}
+		switch {
+		case strings.HasPrefix(req.Query, "query GetIssue"):
+			data = map[string]interface{}{"issue": issue("i1", "", StateTypeStarted, 0)}
+		case strings.HasPrefix(req.Query, "query FilterIssues"):
+			filter := req.Variables["filter"].(map[string]interface{})
+			parentIDs := filter["parent"].(map[string]interface{})["id"].(map[string]interface{})["in"].([]interface{})
+			batches = append(batches, len(parentIDs))
+
+			nodes := []map[string]interface{}{}
+			for _, id := range parentIDs {
+				nodes = append(nodes, children[id.(string)]...)
+			}
+			data = map[string]interface{}{"issues": map[string]interface{}{"nodes": nodes, "pageInfo": PageInfo{}}}
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	tree, err := client.GetIssueTree("i1", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// One query per level: ENG-1, then ENG-2 and ENG-3, then ENG-4 and ENG-5
+	if len(batches) != 3 || batches[0] != 1 || batches[1] != 2 || batches[2] != 2 {
+		t.Errorf("Expected one batched query per level, got parent batches %v", batches)
+	}
+
+	if len(tree.Children) != 2 || len(tree.Children[0].Children) != 2 {
+		t.Fatalf("Expected ENG-1 to have 2 children and ENG-2 to have 2 children, got %+v", tree)
+	}
+
+	rollup := tree.Rollup
+	if rollup.Total != 4 || rollup.Estimate != 6 || rollup.PercentComplete != 33.3 {
+		t.Errorf("Expected 4 descendants, estimate 6 and 33.3%% complete, got %+v", rollup)
+	}
+
+	if tree.Children[1].Rollup != nil {
+		t.Errorf("Expected leaves to have no rollup, got %+v", tree.Children[1].Rollup)
+	}
+
+	want := strings.Join([]string{
+		"ENG-1 Issue 1 [started] (1/3 done, 33.3%, est 6 total)",
+		"  ENG-2 Issue 2 [started] est 3 (1/2 done, 50%, est 3 total)",
+		"    ENG-4 Issue 4 [completed] est 1",
+		"    ENG-5 Issue 5 [unstarted] est 2",
+		"  ENG-3 Issue 3 [canceled]",
+		"",
+	}, "\n")
+	if outline := tree.Outline(); outline != want {
+		t.Errorf("Unexpected outline:\n%s\nwant:\n%s", outline, want)
+	}
+
+	// The node limit truncates the tree
+	tree, err = client.GetIssueTree("i1", &GetIssueTreeOptions{MaxNodes: 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !tree.Truncated || tree.Rollup.Total != 3 {
+		t.Errorf("Expected a truncated tree with 3 sub-issues, got %+v", tree.Rollup)
+	}
+}
@@ -0,0 +1,222 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+)
+
+// Label represents a Linear issue label. Labels with IsGroup set are label
+// groups; labels inside a group reference it through Parent.
+type Label struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+	IsGroup     bool   `json:"isGroup,omitempty"`
+	Parent      *Label `json:"parent,omitempty"`
+}
+
+// GetLabelsOptions contains optional parameters for listing labels
+type GetLabelsOptions struct {
+	First int    // Number of labels to fetch (max 100)
+	After string // Cursor to start fetching after
+}
+
+// GetTeamLabels returns a page of labels available to a specific team
+func (c *Client) GetTeamLabels(teamID string, opts *GetLabelsOptions) (*Page[Label], error) {
+	return c.GetTeamLabelsContext(context.Background(), teamID, opts)
+}
+
+// GetTeamLabelsContext is like GetTeamLabels but honors ctx for cancellation and deadlines
+func (c *Client) GetTeamLabelsContext(ctx context.Context, teamID string, opts *GetLabelsOptions) (*Page[Label], error) {
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	if opts == nil {
+		opts = &GetLabelsOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	data, err := queryInto[struct {
+		Team *struct {
+			Labels Page[Label] `json:"labels"`
+		} `json:"team"`
+	}](ctx, c, "get_team_labels.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Team == nil {
+		return nil, &NotFoundError{Resource: "team", Ref: teamID}
+	}
+
+	return &data.Team.Labels, nil
+}
+
+// GetAllTeamLabels returns up to limit labels of a team, following page
+// cursors as needed. A limit of 0 or less returns every label.
+func (c *Client) GetAllTeamLabels(teamID string, limit int) ([]Label, error) {
+	return c.GetAllTeamLabelsContext(context.Background(), teamID, limit)
+}
+
+// GetAllTeamLabelsContext is like GetAllTeamLabels but honors ctx for cancellation and deadlines
+func (c *Client) GetAllTeamLabelsContext(ctx context.Context, teamID string, limit int) ([]Label, error) {
+	return CollectPages(limit, func(after string) (*Page[Label], error) {
+		return c.GetTeamLabelsContext(ctx, teamID, &GetLabelsOptions{First: maxPageSize, After: after})
+	})
+}
+
+// GetWorkspaceLabels returns a page of workspace-wide labels that are not
+// tied to a single team
+func (c *Client) GetWorkspaceLabels(opts *GetLabelsOptions) (*Page[Label], error) {
+	return c.GetWorkspaceLabelsContext(context.Background(), opts)
+}
+
+// GetWorkspaceLabelsContext is like GetWorkspaceLabels but honors ctx for cancellation and deadlines
+func (c *Client) GetWorkspaceLabelsContext(ctx context.Context, opts *GetLabelsOptions) (*Page[Label], error) {
+	variables := map[string]interface{}{}
+
+	if opts == nil {
+		opts = &GetLabelsOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	data, err := queryInto[struct {
+		IssueLabels Page[Label] `json:"issueLabels"`
+	}](ctx, c, "get_workspace_labels.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.IssueLabels, nil
+}
+
+// GetAllWorkspaceLabels returns up to limit workspace-wide labels, following
+// page cursors as needed. A limit of 0 or less returns every label.
+func (c *Client) GetAllWorkspaceLabels(limit int) ([]Label, error) {
+	return c.GetAllWorkspaceLabelsContext(context.Background(), limit)
+}
+
+// GetAllWorkspaceLabelsContext is like GetAllWorkspaceLabels but honors ctx for cancellation and deadlines
+func (c *Client) GetAllWorkspaceLabelsContext(ctx context.Context, limit int) ([]Label, error) {
+	return CollectPages(limit, func(after string) (*Page[Label], error) {
+		return c.GetWorkspaceLabelsContext(ctx, &GetLabelsOptions{First: maxPageSize, After: after})
+	})
+}
+
+// CreateLabelInput represents input for creating a new label
+type CreateLabelInput struct {
+	Name        string `json:"name"`
+	Color       string `json:"color,omitempty"`       // Hex color, e.g. "#eb5757"
+	Description string `json:"description,omitempty"` // Optional description of when to use the label
+	TeamID      string `json:"teamId,omitempty"`      // Optional team ID; workspace label when empty
+	ParentID    string `json:"parentId,omitempty"`    // Optional label group ID to create the label in
+	IsGroup     bool   `json:"isGroup,omitempty"`     // Whether the label is a group for other labels
+}
+
+// CreateLabel creates a new team or workspace label
+func (c *Client) CreateLabel(input CreateLabelInput) (*Label, error) {
+	return c.CreateLabelContext(context.Background(), input)
+}
+
+// CreateLabelContext is like CreateLabel but honors ctx for cancellation and deadlines
+func (c *Client) CreateLabelContext(ctx context.Context, input CreateLabelInput) (*Label, error) {
+	// Build the input object
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"name": input.Name,
+		},
+	}
+
+	// Add optional fields to the input object
+	inputObj := variables["input"].(map[string]interface{})
+
+	if input.Color != "" {
+		inputObj["color"] = input.Color
+	}
+
+	if input.Description != "" {
+		inputObj["description"] = input.Description
+	}
+
+	if input.TeamID != "" {
+		inputObj["teamId"] = input.TeamID
+	}
+
+	if input.ParentID != "" {
+		inputObj["parentId"] = input.ParentID
+	}
+
+	if input.IsGroup {
+		inputObj["isGroup"] = true
+	}
+
+	data, err := queryInto[struct {
+		IssueLabelCreate struct {
+			Success    bool   `json:"success"`
+			IssueLabel *Label `json:"issueLabel"`
+		} `json:"issueLabelCreate"`
+	}](ctx, c, "create_label.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.IssueLabelCreate.Success || data.IssueLabelCreate.IssueLabel == nil {
+		return nil, fmt.Errorf("label creation was not successful")
+	}
+
+	return data.IssueLabelCreate.IssueLabel, nil
+}
+
+// AddIssueLabel adds a label to an issue, keeping its existing labels
+func (c *Client) AddIssueLabel(issueID, labelID string) (*Issue, error) {
+	return c.AddIssueLabelContext(context.Background(), issueID, labelID)
+}
+
+// AddIssueLabelContext is like AddIssueLabel but honors ctx for cancellation and deadlines
+func (c *Client) AddIssueLabelContext(ctx context.Context, issueID, labelID string) (*Issue, error) {
+	variables := map[string]interface{}{
+		"id":      issueID,
+		"labelId": labelID,
+	}
+
+	data, err := queryInto[struct {
+		IssueAddLabel issuePayload `json:"issueAddLabel"`
+	}](ctx, c, "add_issue_label.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.IssueAddLabel.Success || data.IssueAddLabel.Issue == nil {
+		return nil, fmt.Errorf("adding label was not successful")
+	}
+
+	return data.IssueAddLabel.Issue, nil
+}
+
+// RemoveIssueLabel removes a label from an issue, keeping its other labels
+func (c *Client) RemoveIssueLabel(issueID, labelID string) (*Issue, error) {
+	return c.RemoveIssueLabelContext(context.Background(), issueID, labelID)
+}
+
+// RemoveIssueLabelContext is like RemoveIssueLabel but honors ctx for cancellation and deadlines
+func (c *Client) RemoveIssueLabelContext(ctx context.Context, issueID, labelID string) (*Issue, error) {
+	variables := map[string]interface{}{
+		"id":      issueID,
+		"labelId": labelID,
+	}
+
+	data, err := queryInto[struct {
+		IssueRemoveLabel issuePayload `json:"issueRemoveLabel"`
+	}](ctx, c, "remove_issue_label.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.IssueRemoveLabel.Success || data.IssueRemoveLabel.Issue == nil {
+		return nil, fmt.Errorf("removing label was not successful")
+	}
+
+	return data.IssueRemoveLabel.Issue, nil
+}
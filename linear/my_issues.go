@@ -0,0 +1,181 @@
+package linear
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Ways an issue can relate to the viewer in GetMyIssues
+const (
+	MyIssueAssigned   = "assigned"
+	MyIssueCreated    = "created"
+	MyIssueSubscribed = "subscribed"
+)
+
+// defaultMyIssuesLimit bounds each relationship in GetMyIssues when
+// MyIssuesOptions leaves Limit unset
+const defaultMyIssuesLimit = 100
+
+// myIssuesGroupOrder lists state types in the order GetMyIssues groups them,
+// putting work in progress ahead of work still to pick up
+var myIssuesGroupOrder = []string{
+	StateTypeStarted,
+	StateTypeUnstarted,
+	StateTypeTriage,
+	StateTypeBacklog,
+	StateTypeCompleted,
+	StateTypeCanceled,
+}
+
+// MyIssuesOptions contains optional parameters for GetMyIssues
+type MyIssuesOptions struct {
+	UpdatedSince     string // Only issues updated after this ISO 8601 date or relative duration (e.g. "-P1D")
+	IncludeCompleted bool   // Whether to include completed and canceled issues
+	Limit            int    // Maximum number of issues to fetch per relationship (default 100)
+}
+
+// MyIssue is an issue together with how it relates to the viewer
+type MyIssue struct {
+	Issue
+	Roles   []string `json:"roles"`             // MyIssueAssigned, MyIssueCreated and/or MyIssueSubscribed
+	Overdue bool     `json:"overdue,omitempty"` // Due before today in the viewer's timezone and not yet closed
+}
+
+// MyIssueGroup holds the viewer's issues in one state type
+type MyIssueGroup struct {
+	StateType string    `json:"stateType"`
+	Issues    []MyIssue `json:"issues"`
+}
+
+// MyIssues is the viewer's work, grouped by state type
+type MyIssues struct {
+	Viewer    *User          `json:"viewer"`
+	Groups    []MyIssueGroup `json:"groups"`
+	Total     int            `json:"total"`
+	Truncated bool           `json:"truncated,omitempty"` // Set when Limit cut one of the relationships short
+}
+
+// GetMyIssues returns the issues the authenticated user is assigned to,
+// created or subscribed to, grouped by state type with overdue and
+// high-priority issues first in each group
+func (c *Client) GetMyIssues(opts *MyIssuesOptions) (*MyIssues, error) {
+	return c.GetMyIssuesContext(context.Background(), opts)
+}
+
+// GetMyIssuesContext is like GetMyIssues but honors ctx for cancellation and deadlines
+func (c *Client) GetMyIssuesContext(ctx context.Context, opts *MyIssuesOptions) (*MyIssues, error) {
+	if opts == nil {
+		opts = &MyIssuesOptions{}
+	}
+
+	limit := defaultMyIssuesLimit
+	if opts.Limit > 0 {
+		limit = opts.Limit
+	}
+
+	viewer, err := c.GetViewerContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MyIssues{Viewer: viewer}
+	byID := map[string]*MyIssue{}
+	var order []string
+
+	for _, role := range []string{MyIssueAssigned, MyIssueCreated, MyIssueSubscribed} {
+		filter := &IssueFilter{UpdatedAfter: opts.UpdatedSince}
+		if !opts.IncludeCompleted {
+			filter.StateTypes = []string{StateTypeTriage, StateTypeBacklog, StateTypeUnstarted, StateTypeStarted}
+		}
+
+		switch role {
+		case MyIssueAssigned:
+			filter.AssigneeIDs = []string{viewer.ID}
+		case MyIssueCreated:
+			filter.CreatorIDs = []string{viewer.ID}
+		case MyIssueSubscribed:
+			filter.SubscriberIDs = []string{viewer.ID}
+		}
+
+		// Fetching one extra issue tells us whether the limit cut the list short
+		issues, err := c.SearchAllIssuesContext(ctx, &SearchIssuesOptions{Filter: filter, OrderBy: OrderByUpdatedAt}, limit+1)
+		if err != nil {
+			return nil, err
+		}
+		if len(issues) > limit {
+			issues = issues[:limit]
+			result.Truncated = true
+		}
+
+		for _, issue := range issues {
+			if mine, ok := byID[issue.ID]; ok {
+				mine.Roles = append(mine.Roles, role)
+				continue
+			}
+			byID[issue.ID] = &MyIssue{Issue: issue, Roles: []string{role}}
+			order = append(order, issue.ID)
+		}
+	}
+
+	today := time.Now().In(viewerLocation(viewer)).Format(time.DateOnly)
+	grouped := map[string][]MyIssue{}
+	for _, id := range order {
+		mine := byID[id]
+		mine.Overdue = mine.DueDate != "" && mine.DueDate < today && !isDone(mine.State)
+
+		stateType := ""
+		if mine.State != nil {
+			stateType = mine.State.Type
+		}
+		grouped[stateType] = append(grouped[stateType], *mine)
+	}
+
+	for _, stateType := range myIssuesGroupOrder {
+		if issues, ok := grouped[stateType]; ok {
+			sortMyIssues(issues)
+			result.Groups = append(result.Groups, MyIssueGroup{StateType: stateType, Issues: issues})
+			result.Total += len(issues)
+		}
+	}
+
+	return result, nil
+}
+
+// viewerLocation returns the viewer's timezone, falling back to UTC when it
+// is unset or unknown
+func viewerLocation(viewer *User) *time.Location {
+	if viewer.Timezone != "" {
+		if loc, err := time.LoadLocation(viewer.Timezone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// sortMyIssues orders issues overdue first, then by priority (urgent first,
+// no priority last), then by earliest due date, then most recently updated
+func sortMyIssues(issues []MyIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Overdue != b.Overdue {
+			return a.Overdue
+		}
+		if priorityRank(a.Priority) != priorityRank(b.Priority) {
+			return priorityRank(a.Priority) < priorityRank(b.Priority)
+		}
+		if a.DueDate != b.DueDate {
+			return b.DueDate == "" || (a.DueDate != "" && a.DueDate < b.DueDate)
+		}
+		return a.UpdatedAt > b.UpdatedAt
+	})
+}
+
+// priorityRank maps Linear's priorities so that urgent sorts first and no
+// priority sorts last
+func priorityRank(priority int) int {
+	if priority == 0 {
+		return 5
+	}
+	return priority
+}
@@ -0,0 +1,76 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetMyIssues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetViewer"):
+			w.Write([]byte(`{"data": {"viewer": {"id": "me", "name": "Ada", "timezone": "Not/AZone"}}}`))
+		case strings.HasPrefix(req.Query, "query FilterIssues"):
+			filter, _ := json.Marshal(req.Variables["filter"])
+			if !strings.Contains(string(filter), `"updatedAt":{"gte":"-P1D"}`) || !strings.Contains(string(filter), `"started"`) {
+				t.Errorf("Expected open issues updated in the last day, got %s", filter)
+			}
+
+			switch {
+			case strings.Contains(string(filter), `"assignee"`):
+				w.Write([]byte(`{"data": {"issues": {"nodes": [
+					{"id": "i1", "identifier": "ENG-1", "priority": 3, "state": {"type": "started"}},
+					{"id": "i2", "identifier": "ENG-2", "priority": 1, "state": {"type": "started"}},
+					{"id": "i3", "identifier": "ENG-3", "priority": 4, "dueDate": "2000-01-01", "state": {"type": "started"}}
+				], "pageInfo": {"hasNextPage": false}}}}`))
+			case strings.Contains(string(filter), `"creator"`):
+				w.Write([]byte(`{"data": {"issues": {"nodes": [
+					{"id": "i2", "identifier": "ENG-2", "priority": 1, "state": {"type": "started"}},
+					{"id": "i4", "identifier": "ENG-4", "priority": 0, "state": {"type": "backlog"}}
+				], "pageInfo": {"hasNextPage": false}}}}`))
+			case strings.Contains(string(filter), `"subscribers":{"some":{"id":{"in":["me"]}}}`):
+				w.Write([]byte(`{"data": {"issues": {"nodes": [], "pageInfo": {"hasNextPage": false}}}}`))
+			default:
+				t.Errorf("Unexpected filter: %s", filter)
+			}
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	mine, err := client.GetMyIssues(&MyIssuesOptions{UpdatedSince: "-P1D"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if mine.Total != 4 || len(mine.Groups) != 2 || mine.Groups[0].StateType != StateTypeStarted || mine.Groups[1].StateType != StateTypeBacklog {
+		t.Fatalf("Expected started issues ahead of the backlog, got %+v", mine.Groups)
+	}
+
+	var order []string
+	for _, issue := range mine.Groups[0].Issues {
+		order = append(order, issue.Identifier)
+	}
+	if strings.Join(order, ",") != "ENG-3,ENG-2,ENG-1" {
+		t.Errorf("Expected the overdue issue first, then by priority, got %v", order)
+	}
+
+	if !mine.Groups[0].Issues[0].Overdue || strings.Join(mine.Groups[0].Issues[1].Roles, ",") != "assigned,created" {
+		t.Errorf("Expected ENG-3 to be overdue and ENG-2 to carry both roles, got %+v", mine.Groups[0].Issues)
+	}
+}
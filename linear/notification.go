@@ -0,0 +1,305 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultInboxLimit bounds GetNotificationInbox when
+// NotificationInboxOptions leaves Limit unset
+const defaultInboxLimit = 50
+
+// inboxScanLimit caps how many notifications GetNotificationInbox looks
+// through, since Linear cannot filter notifications by read state
+const inboxScanLimit = 500
+
+// Notification represents an entry in the authenticated user's Linear inbox
+type Notification struct {
+	ID             string   `json:"id"`
+	Type           string   `json:"type"` // e.g. "issueMention", "issueAssignedToYou" or "issueStatusChanged"
+	Actor          *User    `json:"actor,omitempty"`
+	Issue          *Issue   `json:"issue,omitempty"`   // Set for issue notifications
+	Comment        *Comment `json:"comment,omitempty"` // Set when the notification is about a comment
+	Project        *Project `json:"project,omitempty"` // Set for project notifications
+	CreatedAt      string   `json:"createdAt"`
+	ReadAt         string   `json:"readAt,omitempty"`
+	SnoozedUntilAt string   `json:"snoozedUntilAt,omitempty"`
+	ArchivedAt     string   `json:"archivedAt,omitempty"`
+}
+
+// Snoozed reports whether the notification is snoozed until a time after now
+func (n *Notification) Snoozed(now time.Time) bool {
+	if n.SnoozedUntilAt == "" {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, n.SnoozedUntilAt)
+	return err == nil && until.After(now)
+}
+
+// notificationPayload is the result of a notification mutation
+type notificationPayload struct {
+	Success      bool          `json:"success"`
+	Notification *Notification `json:"notification"`
+}
+
+// GetNotificationsOptions contains optional parameters for listing notifications
+type GetNotificationsOptions struct {
+	First           int    // Number of notifications to fetch (max 100)
+	After           string // Cursor to start fetching after
+	IncludeArchived bool   // Whether to include archived notifications
+}
+
+// GetNotifications returns a page of the authenticated user's notifications,
+// newest first
+func (c *Client) GetNotifications(opts *GetNotificationsOptions) (*Page[Notification], error) {
+	return c.GetNotificationsContext(context.Background(), opts)
+}
+
+// GetNotificationsContext is like GetNotifications but honors ctx for cancellation and deadlines
+func (c *Client) GetNotificationsContext(ctx context.Context, opts *GetNotificationsOptions) (*Page[Notification], error) {
+	variables := map[string]interface{}{}
+
+	if opts == nil {
+		opts = &GetNotificationsOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	if opts.IncludeArchived {
+		variables["includeArchived"] = true
+	}
+
+	data, err := queryInto[struct {
+		Notifications Page[Notification] `json:"notifications"`
+	}](ctx, c, "get_notifications.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.Notifications, nil
+}
+
+// NotificationInboxOptions contains optional parameters for GetNotificationInbox
+type NotificationInboxOptions struct {
+	Limit          int  // Maximum number of notifications to return (default 50)
+	IncludeRead    bool // Whether to include notifications that were already read
+	IncludeSnoozed bool // Whether to include notifications snoozed until later
+}
+
+// NotificationInbox is a summary of the authenticated user's inbox
+type NotificationInbox struct {
+	Unread        int            `json:"unread"` // Number of unread notifications returned
+	ByType        map[string]int `json:"byType"` // Number of notifications returned per type
+	Notifications []Notification `json:"notifications"`
+	Truncated     bool           `json:"truncated,omitempty"` // Set when more notifications matched than were returned
+}
+
+// GetNotificationInbox returns the authenticated user's unarchived
+// notifications, newest first. Only unread notifications that are not
+// snoozed are returned unless the options ask for more.
+func (c *Client) GetNotificationInbox(opts *NotificationInboxOptions) (*NotificationInbox, error) {
+	return c.GetNotificationInboxContext(context.Background(), opts)
+}
+
+// GetNotificationInboxContext is like GetNotificationInbox but honors ctx for cancellation and deadlines
+func (c *Client) GetNotificationInboxContext(ctx context.Context, opts *NotificationInboxOptions) (*NotificationInbox, error) {
+	if opts == nil {
+		opts = &NotificationInboxOptions{}
+	}
+
+	limit := defaultInboxLimit
+	if opts.Limit > 0 {
+		limit = opts.Limit
+	}
+
+	inbox := &NotificationInbox{ByType: map[string]int{}, Notifications: []Notification{}}
+	now := time.Now()
+	scanned := 0
+	after := ""
+
+	for {
+		page, err := c.GetNotificationsContext(ctx, &GetNotificationsOptions{First: maxPageSize, After: after})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range page.Nodes {
+			scanned++
+			if (n.ReadAt != "" && !opts.IncludeRead) || (n.Snoozed(now) && !opts.IncludeSnoozed) {
+				continue
+			}
+
+			if len(inbox.Notifications) == limit {
+				inbox.Truncated = true
+				return inbox, nil
+			}
+
+			inbox.Notifications = append(inbox.Notifications, n)
+			inbox.ByType[n.Type]++
+			if n.ReadAt == "" {
+				inbox.Unread++
+			}
+		}
+
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" || page.PageInfo.EndCursor == after {
+			return inbox, nil
+		}
+		if scanned >= inboxScanLimit {
+			inbox.Truncated = true
+			return inbox, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// Summary renders the inbox as a short text digest, one line per notification
+func (inbox *NotificationInbox) Summary() string {
+	var b strings.Builder
+
+	if len(inbox.Notifications) == 0 {
+		b.WriteString("No notifications\n")
+		return b.String()
+	}
+
+	types := make([]string, 0, len(inbox.ByType))
+	for t := range inbox.ByType {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if inbox.ByType[types[i]] != inbox.ByType[types[j]] {
+			return inbox.ByType[types[i]] > inbox.ByType[types[j]]
+		}
+		return types[i] < types[j]
+	})
+
+	counts := make([]string, len(types))
+	for i, t := range types {
+		counts[i] = fmt.Sprintf("%d %s", inbox.ByType[t], t)
+	}
+	fmt.Fprintf(&b, "%d notifications, %d unread: %s\n\n", len(inbox.Notifications), inbox.Unread, strings.Join(counts, ", "))
+
+	for _, n := range inbox.Notifications {
+		fmt.Fprintf(&b, "- %s", n.Type)
+		switch {
+		case n.Issue != nil:
+			fmt.Fprintf(&b, " %s %s", n.Issue.Identifier, n.Issue.Title)
+			if n.Issue.State != nil {
+				fmt.Fprintf(&b, " [%s]", n.Issue.State.Name)
+			}
+		case n.Project != nil:
+			fmt.Fprintf(&b, " project %s", n.Project.Name)
+		}
+		if n.Actor != nil {
+			fmt.Fprintf(&b, " by %s", n.Actor.Name)
+		}
+		fmt.Fprintf(&b, " at %s", n.CreatedAt)
+		if n.ReadAt != "" {
+			b.WriteString(" (read)")
+		}
+		fmt.Fprintf(&b, " [id %s]\n", n.ID)
+
+		if n.Comment != nil && n.Comment.Body != "" {
+			fmt.Fprintf(&b, "  %q\n", excerpt(n.Comment.Body, 140))
+		}
+	}
+
+	if inbox.Truncated {
+		b.WriteString("… more notifications were not loaded; raise the limit to see them\n")
+	}
+	return b.String()
+}
+
+// excerpt collapses whitespace in s and shortens it to at most n runes
+func excerpt(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n-1]) + "…"
+	}
+	return s
+}
+
+// MarkNotificationRead marks a notification as read
+func (c *Client) MarkNotificationRead(notificationID string) (*Notification, error) {
+	return c.MarkNotificationReadContext(context.Background(), notificationID)
+}
+
+// MarkNotificationReadContext is like MarkNotificationRead but honors ctx for cancellation and deadlines
+func (c *Client) MarkNotificationReadContext(ctx context.Context, notificationID string) (*Notification, error) {
+	return c.updateNotification(ctx, notificationID, map[string]interface{}{
+		"readAt": time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// MarkNotificationUnread marks a notification as unread again
+func (c *Client) MarkNotificationUnread(notificationID string) (*Notification, error) {
+	return c.MarkNotificationUnreadContext(context.Background(), notificationID)
+}
+
+// MarkNotificationUnreadContext is like MarkNotificationUnread but honors ctx for cancellation and deadlines
+func (c *Client) MarkNotificationUnreadContext(ctx context.Context, notificationID string) (*Notification, error) {
+	return c.updateNotification(ctx, notificationID, map[string]interface{}{
+		"readAt": nil,
+	})
+}
+
+// SnoozeNotification hides a notification from the inbox until the given
+// time. A zero time unsnoozes it.
+func (c *Client) SnoozeNotification(notificationID string, until time.Time) (*Notification, error) {
+	return c.SnoozeNotificationContext(context.Background(), notificationID, until)
+}
+
+// SnoozeNotificationContext is like SnoozeNotification but honors ctx for cancellation and deadlines
+func (c *Client) SnoozeNotificationContext(ctx context.Context, notificationID string, until time.Time) (*Notification, error) {
+	var snoozedUntil interface{}
+	if !until.IsZero() {
+		snoozedUntil = until.UTC().Format(time.RFC3339)
+	}
+
+	return c.updateNotification(ctx, notificationID, map[string]interface{}{
+		"snoozedUntilAt": snoozedUntil,
+	})
+}
+
+// updateNotification applies input to a notification, sending nil values as
+// null so that fields can be cleared
+func (c *Client) updateNotification(ctx context.Context, notificationID string, input map[string]interface{}) (*Notification, error) {
+	variables := map[string]interface{}{
+		"id":    notificationID,
+		"input": input,
+	}
+
+	data, err := queryInto[struct {
+		NotificationUpdate notificationPayload `json:"notificationUpdate"`
+	}](ctx, c, "update_notification.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.NotificationUpdate.Success || data.NotificationUpdate.Notification == nil {
+		return nil, fmt.Errorf("notification update was not successful")
+	}
+
+	return data.NotificationUpdate.Notification, nil
+}
+
+// ArchiveNotification removes a notification from the inbox
+func (c *Client) ArchiveNotification(notificationID string) error {
+	return c.ArchiveNotificationContext(context.Background(), notificationID)
+}
+
+// ArchiveNotificationContext is like ArchiveNotification but honors ctx for cancellation and deadlines
+func (c *Client) ArchiveNotificationContext(ctx context.Context, notificationID string) error {
+	return c.archiveMutation(ctx, "archive_notification.graphql", "notificationArchive", notificationID, "notification archive")
+}
+
+// UnarchiveNotification returns an archived notification to the inbox
+func (c *Client) UnarchiveNotification(notificationID string) error {
+	return c.UnarchiveNotificationContext(context.Background(), notificationID)
+}
+
+// UnarchiveNotificationContext is like UnarchiveNotification but honors ctx for cancellation and deadlines
+func (c *Client) UnarchiveNotificationContext(ctx context.Context, notificationID string) error {
+	return c.archiveMutation(ctx, "unarchive_notification.graphql", "notificationUnarchive", notificationID, "notification unarchive")
+}
@@ -0,0 +1,122 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNotificationInbox(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetNotifications"):
+			if req.Variables["after"] == nil {
+				w.Write([]byte(`{"data": {"notifications": {"nodes": [
+					{"id": "n1", "type": "issueMention", "createdAt": "2024-05-02T10:00:00Z", "actor": {"id": "u1", "name": "Ada"},
+					 "issue": {"id": "i1", "identifier": "ENG-1", "title": "Fix login", "state": {"name": "In Review", "type": "started"}},
+					 "comment": {"id": "c1", "body": "Can you\nreview this?"}},
+					{"id": "n2", "type": "issueStatusChanged", "createdAt": "2024-05-02T09:00:00Z", "readAt": "2024-05-02T09:30:00Z"}
+				], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}`))
+				return
+			}
+			w.Write([]byte(`{"data": {"notifications": {"nodes": [
+				{"id": "n3", "type": "issueAssignedToYou", "createdAt": "2024-05-01T09:00:00Z", "snoozedUntilAt": "2999-01-01T00:00:00Z"},
+				{"id": "n4", "type": "issueMention", "createdAt": "2024-05-01T08:00:00Z", "project": {"id": "p1", "name": "Launch"}}
+			], "pageInfo": {"hasNextPage": false}}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	inbox, err := client.GetNotificationInbox(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(inbox.Notifications) != 2 || inbox.Notifications[0].ID != "n1" || inbox.Notifications[1].ID != "n4" {
+		t.Fatalf("Expected only the unread, unsnoozed notifications, got %+v", inbox.Notifications)
+	}
+	if inbox.Unread != 2 || inbox.ByType["issueMention"] != 2 {
+		t.Errorf("Expected two unread mentions, got %+v", inbox)
+	}
+
+	summary := inbox.Summary()
+	for _, want := range []string{"2 issueMention", "ENG-1 Fix login [In Review] by Ada", `"Can you review this?"`, "project Launch"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Expected the summary to contain %q, got:\n%s", want, summary)
+		}
+	}
+
+	inbox, err = client.GetNotificationInbox(&NotificationInboxOptions{IncludeRead: true, IncludeSnoozed: true, Limit: 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(inbox.Notifications) != 3 || !inbox.Truncated || inbox.Unread != 2 {
+		t.Errorf("Expected three notifications cut short by the limit, got %+v", inbox)
+	}
+}
+
+func TestUpdateNotification(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "mutation UpdateNotification"):
+			input, _ := json.Marshal(req.Variables["input"])
+			switch string(input) {
+			case `{"readAt":null}`:
+				w.Write([]byte(`{"data": {"notificationUpdate": {"success": true, "notification": {"id": "n1", "type": "issueMention"}}}}`))
+			case `{"snoozedUntilAt":"2030-01-02T03:04:05Z"}`:
+				w.Write([]byte(`{"data": {"notificationUpdate": {"success": true, "notification": {"id": "n1", "snoozedUntilAt": "2030-01-02T03:04:05Z"}}}}`))
+			default:
+				t.Errorf("Unexpected notification input: %s", input)
+			}
+		case strings.HasPrefix(req.Query, "mutation ArchiveNotification"):
+			w.Write([]byte(`{"data": {"notificationArchive": {"success": false}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	if _, err := client.MarkNotificationUnread("n1"); err != nil {
+		t.Errorf("Expected the read time to be cleared: %v", err)
+	}
+
+	notification, err := client.SnoozeNotification("n1", time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !notification.Snoozed(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the notification to be snoozed, got %+v", notification)
+	}
+
+	if err := client.ArchiveNotification("n1"); err == nil {
+		t.Errorf("Expected an unsuccessful archive to fail")
+	}
+}
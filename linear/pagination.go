@@ -0,0 +1,96 @@
+package linear
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PageInfo holds the cursor information for a paginated Linear connection
+type PageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor,omitempty"`
+}
+
+// Page represents a single page of results from a paginated Linear connection
+type Page[T any] struct {
+	Nodes    []T      `json:"nodes"`
+	PageInfo PageInfo `json:"pageInfo"`
+}
+
+// defaultPageSize is used when a caller does not specify how many items to fetch
+const defaultPageSize = 50
+
+// maxPageSize is the largest page size accepted by the Linear API
+const maxPageSize = 100
+
+// pageSize returns a page size within the bounds accepted by the Linear API
+func pageSize(first int) int {
+	if first > 0 && first <= maxPageSize {
+		return first
+	}
+	return defaultPageSize
+}
+
+// paginationVariables adds the first/after pagination variables to a variables map
+func paginationVariables(variables map[string]interface{}, first int, after string) {
+	variables["first"] = pageSize(first)
+	if after != "" {
+		variables["after"] = after
+	}
+}
+
+// PageFetcher fetches a single page of results starting after the given cursor
+type PageFetcher[T any] func(after string) (*Page[T], error)
+
+// CollectPages follows cursors from fetch until there are no more pages or
+// limit items have been collected. A limit of 0 or less collects every page.
+func CollectPages[T any](limit int, fetch PageFetcher[T]) ([]T, error) {
+	var (
+		results []T
+		after   string
+	)
+
+	for {
+		page, err := fetch(after)
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, page.Nodes...)
+
+		if limit > 0 && len(results) >= limit {
+			return results[:limit], nil
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return results, nil
+		}
+
+		if page.PageInfo.EndCursor == "" || page.PageInfo.EndCursor == after {
+			return results, fmt.Errorf("pagination did not advance past cursor %q", after)
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// Nodes is a list of items nested in a connection. It decodes from either a
+// {"nodes": [...]} connection object or a plain array, and encodes as an array.
+type Nodes[T any] []T
+
+// UnmarshalJSON implements json.Unmarshaler
+func (n *Nodes[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err == nil {
+		*n = items
+		return nil
+	}
+
+	var connection struct {
+		Nodes []T `json:"nodes"`
+	}
+	if err := json.Unmarshal(data, &connection); err != nil {
+		return err
+	}
+	*n = connection.Nodes
+	return nil
+}
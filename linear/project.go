@@ -1,246 +1,140 @@
 package linear
 
 import (
+	"context"
 	"fmt"
 )
 
 // Project represents a Linear project
 type Project struct {
-	ID          string        `json:"id"`
-	Name        string        `json:"name"`
-	Description string        `json:"description,omitempty"`
-	Icon        string        `json:"icon,omitempty"`
-	Color       string        `json:"color,omitempty"`
-	State       string        `json:"state,omitempty"`
-	Status      *ProjectStatus `json:"status,omitempty"`
-	Lead        *User         `json:"lead,omitempty"`
-	Teams       []Team        `json:"teams,omitempty"`
-	Issues      []Issue       `json:"issues,omitempty"`
-	CreatedAt   string        `json:"createdAt"`
-	UpdatedAt   string        `json:"updatedAt,omitempty"`
-	StartedAt   string        `json:"startedAt,omitempty"`
-	TargetDate  string        `json:"targetDate,omitempty"`
-	SortOrder   float64       `json:"sortOrder,omitempty"`
-	URL         string        `json:"url,omitempty"`
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	SlugID      string             `json:"slugId,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Icon        string             `json:"icon,omitempty"`
+	Color       string             `json:"color,omitempty"`
+	State       string             `json:"state,omitempty"`
+	Status      *ProjectStatus     `json:"status,omitempty"`
+	Lead        *User              `json:"lead,omitempty"`
+	Teams       []Team             `json:"teams,omitempty"`
+	Issues      []Issue            `json:"issues,omitempty"`
+	Milestones  []ProjectMilestone `json:"milestones,omitempty"`
+	CreatedAt   string             `json:"createdAt"`
+	UpdatedAt   string             `json:"updatedAt,omitempty"`
+	StartedAt   string             `json:"startedAt,omitempty"`
+	TargetDate  string             `json:"targetDate,omitempty"`
+	SortOrder   float64            `json:"sortOrder,omitempty"`
+	URL         string             `json:"url,omitempty"`
+}
+
+// projectNode is a Project as returned by the API, with its connections
+// still wrapped in nodes
+type projectNode struct {
+	Project
+	Teams             Page[Team]             `json:"teams"`
+	Issues            Page[Issue]            `json:"issues"`
+	ProjectMilestones Page[ProjectMilestone] `json:"projectMilestones"`
+}
+
+// toProject unwraps the node's connections into a Project
+func (n *projectNode) toProject() *Project {
+	project := n.Project
+	project.Teams = n.Teams.Nodes
+	project.Issues = n.Issues.Nodes
+	project.Milestones = n.ProjectMilestones.Nodes
+	return &project
+}
+
+// projectPayload is the result of a project mutation
+type projectPayload struct {
+	Success bool         `json:"success"`
+	Project *projectNode `json:"project"`
 }
 
 // GetProjectsOptions contains optional parameters for listing projects
 type GetProjectsOptions struct {
 	First int    // Number of projects to fetch (max 100)
+	After string // Cursor to start fetching after
 	State string // Filter by project state (started, planned, paused, completed, canceled)
 }
 
-// GetProjects returns all projects in the Linear workspace with optional filtering
-func (c *Client) GetProjects(opts *GetProjectsOptions) ([]Project, error) {
+// GetProjects returns a page of projects in the Linear workspace with optional filtering
+func (c *Client) GetProjects(opts *GetProjectsOptions) (*Page[Project], error) {
+	return c.GetProjectsContext(context.Background(), opts)
+}
+
+// GetProjectsContext is like GetProjects but honors ctx for cancellation and deadlines
+func (c *Client) GetProjectsContext(ctx context.Context, opts *GetProjectsOptions) (*Page[Project], error) {
 	variables := map[string]interface{}{}
 
-	first := 50
-	if opts != nil && opts.First > 0 && opts.First <= 100 {
-		first = opts.First
-	}
-	variables["first"] = first
-
-	var filterClause string
-	if opts != nil && opts.State != "" {
-		filterClause = ", filter: { state: { eq: \"" + opts.State + "\" } }"
-	}
-
-	query := fmt.Sprintf(`query GetProjects($first: Int!) {
-		projects(first: $first%s) {
-			nodes {
-				id
-				name
-				description
-				icon
-				color
-				state
-				createdAt
-				updatedAt
-				startedAt
-				targetDate
-				sortOrder
-				url
-				lead {
-					id
-					name
-					email
-				}
-				teams {
-					nodes {
-						id
-						name
-						key
-					}
-				}
-			}
+	if opts == nil {
+		opts = &GetProjectsOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	if opts.State != "" {
+		variables["filter"] = map[string]interface{}{
+			"state": map[string]interface{}{"eq": opts.State},
 		}
-	}`, filterClause)
+	}
 
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		Projects Page[projectNode] `json:"projects"`
+	}](ctx, c, "get_projects.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	projectsData, ok := resp.Data["projects"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid projects data format")
-	}
-
-	nodesData, ok := projectsData["nodes"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid projects nodes format")
+	projects := make([]Project, 0, len(data.Projects.Nodes))
+	for i := range data.Projects.Nodes {
+		projects = append(projects, *data.Projects.Nodes[i].toProject())
 	}
 
-	projects := make([]Project, 0, len(nodesData))
-	for _, node := range nodesData {
-		nodeMap, ok := node.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("invalid project node format")
-		}
-
-		project := Project{
-			ID:          safeGetString(nodeMap, "id"),
-			Name:        safeGetString(nodeMap, "name"),
-			Description: safeGetString(nodeMap, "description"),
-			Icon:        safeGetString(nodeMap, "icon"),
-			Color:       safeGetString(nodeMap, "color"),
-			State:       safeGetString(nodeMap, "state"),
-			CreatedAt:   safeGetString(nodeMap, "createdAt"),
-			UpdatedAt:   safeGetString(nodeMap, "updatedAt"),
-			StartedAt:   safeGetString(nodeMap, "startedAt"),
-			TargetDate:  safeGetString(nodeMap, "targetDate"),
-			SortOrder:   safeGetFloat64(nodeMap, "sortOrder"),
-			URL:         safeGetString(nodeMap, "url"),
-		}
-
-		if leadMap, ok := nodeMap["lead"].(map[string]interface{}); ok {
-			project.Lead = &User{
-				ID:    safeGetString(leadMap, "id"),
-				Name:  safeGetString(leadMap, "name"),
-				Email: safeGetString(leadMap, "email"),
-			}
-		}
+	return &Page[Project]{Nodes: projects, PageInfo: data.Projects.PageInfo}, nil
+}
 
-		if teamsMap, ok := nodeMap["teams"].(map[string]interface{}); ok {
-			if teamsNodes, ok := teamsMap["nodes"].([]interface{}); ok {
-				teams := make([]Team, 0, len(teamsNodes))
-				for _, teamNode := range teamsNodes {
-					teamMap, ok := teamNode.(map[string]interface{})
-					if !ok {
-						continue
-					}
-					team := Team{
-						ID:   safeGetString(teamMap, "id"),
-						Name: safeGetString(teamMap, "name"),
-						Key:  safeGetString(teamMap, "key"),
-					}
-					teams = append(teams, team)
-				}
-				project.Teams = teams
-			}
-		}
+// GetAllProjects returns up to limit projects matching opts, following page
+// cursors as needed. A limit of 0 or less returns every matching project.
+func (c *Client) GetAllProjects(opts *GetProjectsOptions, limit int) ([]Project, error) {
+	return c.GetAllProjectsContext(context.Background(), opts, limit)
+}
 
-		projects = append(projects, project)
+// GetAllProjectsContext is like GetAllProjects but honors ctx for cancellation and deadlines
+func (c *Client) GetAllProjectsContext(ctx context.Context, opts *GetProjectsOptions, limit int) ([]Project, error) {
+	var state string
+	if opts != nil {
+		state = opts.State
 	}
 
-	return projects, nil
+	return CollectPages(limit, func(after string) (*Page[Project], error) {
+		return c.GetProjectsContext(ctx, &GetProjectsOptions{First: maxPageSize, After: after, State: state})
+	})
 }
 
-// GetProject returns details of a specific project by ID
+// GetProject returns details of a specific project by ID, including its
+// issues and milestones
 func (c *Client) GetProject(projectID string) (*Project, error) {
+	return c.GetProjectContext(context.Background(), projectID)
+}
+
+// GetProjectContext is like GetProject but honors ctx for cancellation and deadlines
+func (c *Client) GetProjectContext(ctx context.Context, projectID string) (*Project, error) {
 	variables := map[string]interface{}{
 		"id": projectID,
 	}
 
-	query := `query GetProject($id: String!) {
-		project(id: $id) {
-			id
-			name
-			description
-			icon
-			color
-			state
-			createdAt
-			updatedAt
-			startedAt
-			targetDate
-			sortOrder
-			url
-			lead {
-				id
-				name
-				email
-			}
-			teams {
-				nodes {
-					id
-					name
-					key
-				}
-			}
-			issues {
-				nodes {
-					id
-					identifier
-					title
-				}
-			}
-		}
-	}`
-
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		Project *projectNode `json:"project"`
+	}](ctx, c, "get_project.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	projectData, ok := resp.Data["project"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid project data format")
-	}
-
-	project := &Project{
-		ID:          safeGetString(projectData, "id"),
-		Name:        safeGetString(projectData, "name"),
-		Description: safeGetString(projectData, "description"),
-		Icon:        safeGetString(projectData, "icon"),
-		Color:       safeGetString(projectData, "color"),
-		State:       safeGetString(projectData, "state"),
-		CreatedAt:   safeGetString(projectData, "createdAt"),
-		UpdatedAt:   safeGetString(projectData, "updatedAt"),
-		StartedAt:   safeGetString(projectData, "startedAt"),
-		TargetDate:  safeGetString(projectData, "targetDate"),
-		SortOrder:   safeGetFloat64(projectData, "sortOrder"),
-		URL:         safeGetString(projectData, "url"),
-	}
-
-	if leadMap, ok := projectData["lead"].(map[string]interface{}); ok {
-		project.Lead = &User{
-			ID:    safeGetString(leadMap, "id"),
-			Name:  safeGetString(leadMap, "name"),
-			Email: safeGetString(leadMap, "email"),
-		}
+	if data.Project == nil {
+		return nil, &NotFoundError{Resource: "project", Ref: projectID}
 	}
 
-	if teamsMap, ok := projectData["teams"].(map[string]interface{}); ok {
-		if teamsNodes, ok := teamsMap["nodes"].([]interface{}); ok {
-			teams := make([]Team, 0, len(teamsNodes))
-			for _, teamNode := range teamsNodes {
-				teamMap, ok := teamNode.(map[string]interface{})
-				if !ok {
-					continue
-				}
-				team := Team{
-					ID:   safeGetString(teamMap, "id"),
-					Name: safeGetString(teamMap, "name"),
-					Key:  safeGetString(teamMap, "key"),
-				}
-				teams = append(teams, team)
-			}
-			project.Teams = teams
-		}
-	}
-
-	return project, nil
+	return data.Project.toProject(), nil
 }
 
 // CreateProjectInput represents input for creating a new project
@@ -258,6 +152,11 @@ type CreateProjectInput struct {
 
 // CreateProject creates a new project in Linear
 func (c *Client) CreateProject(input CreateProjectInput) (*Project, error) {
+	return c.CreateProjectContext(context.Background(), input)
+}
+
+// CreateProjectContext is like CreateProject but honors ctx for cancellation and deadlines
+func (c *Client) CreateProjectContext(ctx context.Context, input CreateProjectInput) (*Project, error) {
 	// Build the input object
 	variables := map[string]interface{}{
 		"input": map[string]interface{}{
@@ -297,183 +196,88 @@ func (c *Client) CreateProject(input CreateProjectInput) (*Project, error) {
 		inputObj["targetDate"] = input.TargetDate
 	}
 
-	query := `mutation CreateProject($input: ProjectCreateInput!) {
-		projectCreate(input: $input) {
-			success
-			project {
-				id
-				name
-				description
-				icon
-				color
-				state
-				createdAt
-				updatedAt
-				startedAt
-				targetDate
-				sortOrder
-				url
-				lead {
-					id
-					name
-					email
-				}
-				teams {
-					nodes {
-						id
-						name
-						key
-					}
-				}
-			}
-		}
-	}`
-
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		ProjectCreate projectPayload `json:"projectCreate"`
+	}](ctx, c, "create_project.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	projectCreateData, ok := resp.Data["projectCreate"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid projectCreate data format")
-	}
-
-	success, ok := projectCreateData["success"].(bool)
-	if !ok || !success {
+	if !data.ProjectCreate.Success || data.ProjectCreate.Project == nil {
 		return nil, fmt.Errorf("project creation was not successful")
 	}
 
-	projectData, ok := projectCreateData["project"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid project data format")
-	}
-
-	project := &Project{
-		ID:          safeGetString(projectData, "id"),
-		Name:        safeGetString(projectData, "name"),
-		Description: safeGetString(projectData, "description"),
-		Icon:        safeGetString(projectData, "icon"),
-		Color:       safeGetString(projectData, "color"),
-		State:       safeGetString(projectData, "state"),
-		CreatedAt:   safeGetString(projectData, "createdAt"),
-		UpdatedAt:   safeGetString(projectData, "updatedAt"),
-		StartedAt:   safeGetString(projectData, "startedAt"),
-		TargetDate:  safeGetString(projectData, "targetDate"),
-		SortOrder:   safeGetFloat64(projectData, "sortOrder"),
-		URL:         safeGetString(projectData, "url"),
-	}
-
-	if leadMap, ok := projectData["lead"].(map[string]interface{}); ok {
-		project.Lead = &User{
-			ID:    safeGetString(leadMap, "id"),
-			Name:  safeGetString(leadMap, "name"),
-			Email: safeGetString(leadMap, "email"),
-		}
-	}
-
-	if teamsMap, ok := projectData["teams"].(map[string]interface{}); ok {
-		if teamsNodes, ok := teamsMap["nodes"].([]interface{}); ok {
-			teams := make([]Team, 0, len(teamsNodes))
-			for _, teamNode := range teamsNodes {
-				teamMap, ok := teamNode.(map[string]interface{})
-				if !ok {
-					continue
-				}
-				team := Team{
-					ID:   safeGetString(teamMap, "id"),
-					Name: safeGetString(teamMap, "name"),
-					Key:  safeGetString(teamMap, "key"),
-				}
-				teams = append(teams, team)
-			}
-			project.Teams = teams
-		}
-	}
-
-	return project, nil
+	return data.ProjectCreate.Project.toProject(), nil
 }
 
-// ProjectWithIssues represents a Linear project with its issues
+// ProjectWithIssues represents a Linear project with a page of its issues
 type ProjectWithIssues struct {
-	ID     string        `json:"id"`
-	Status *ProjectStatus `json:"status,omitempty"`
-	Issues []Issue       `json:"issues"`
+	ID       string         `json:"id"`
+	Status   *ProjectStatus `json:"status,omitempty"`
+	Issues   []Issue        `json:"issues"`
+	PageInfo PageInfo       `json:"pageInfo"`
 }
 
 // GetProjectIssuesOptions contains optional parameters for fetching project issues
 type GetProjectIssuesOptions struct {
-	First int // Number of issues to fetch (max 100)
+	First int    // Number of issues to fetch (max 100)
+	After string // Cursor to start fetching after
 }
 
 // GetProjectIssues returns issues for a specific project
 func (c *Client) GetProjectIssues(projectID string, opts *GetProjectIssuesOptions) (*ProjectWithIssues, error) {
-	query, err := getGraphQLQuery("get_project_issues.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load GraphQL query: %w", err)
-	}
+	return c.GetProjectIssuesContext(context.Background(), projectID, opts)
+}
 
+// GetProjectIssuesContext is like GetProjectIssues but honors ctx for cancellation and deadlines
+func (c *Client) GetProjectIssuesContext(ctx context.Context, projectID string, opts *GetProjectIssuesOptions) (*ProjectWithIssues, error) {
 	variables := map[string]interface{}{
 		"projectId": projectID,
 	}
 
-	resp, err := c.ExecuteGraphQL(query, variables)
+	if opts == nil {
+		opts = &GetProjectIssuesOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	data, err := queryInto[struct {
+		Project *struct {
+			ID     string         `json:"id"`
+			Status *ProjectStatus `json:"status"`
+			Issues Page[Issue]    `json:"issues"`
+		} `json:"project"`
+	}](ctx, c, "get_project_issues.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	// Extract the project data
-	projectData, ok := resp.Data["project"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid project data format")
+	if data.Project == nil {
+		return nil, &NotFoundError{Resource: "project", Ref: projectID}
 	}
 
-	project := &ProjectWithIssues{
-		ID: safeGetString(projectData, "id"),
-	}
+	return &ProjectWithIssues{
+		ID:       data.Project.ID,
+		Status:   data.Project.Status,
+		Issues:   data.Project.Issues.Nodes,
+		PageInfo: data.Project.Issues.PageInfo,
+	}, nil
+}
 
-	// Extract status if present
-	if statusMap, ok := projectData["status"].(map[string]interface{}); ok {
-		project.Status = &ProjectStatus{
-			ID:   safeGetString(statusMap, "id"),
-			Name: safeGetString(statusMap, "name"),
-		}
-	}
+// GetAllProjectIssues returns up to limit issues for a project, following page
+// cursors as needed. A limit of 0 or less returns every issue in the project.
+func (c *Client) GetAllProjectIssues(projectID string, limit int) ([]Issue, error) {
+	return c.GetAllProjectIssuesContext(context.Background(), projectID, limit)
+}
 
-	// Extract issues data
-	if issuesData, ok := projectData["issues"].(map[string]interface{}); ok {
-		if nodesData, ok := issuesData["nodes"].([]interface{}); ok {
-			issues := make([]Issue, 0, len(nodesData))
-			
-			for _, node := range nodesData {
-				nodeMap, ok := node.(map[string]interface{})
-				if !ok {
-					continue
-				}
-
-				issue := Issue{
-					ID:          safeGetString(nodeMap, "id"),
-					Title:       safeGetString(nodeMap, "title"),
-					Description: safeGetString(nodeMap, "description"),
-				}
-
-				// Extract assignee if present
-				if assigneeMap, ok := nodeMap["assignee"].(map[string]interface{}); ok {
-					issue.Assignee = &User{
-						ID:    safeGetString(assigneeMap, "id"),
-						Name:  safeGetString(assigneeMap, "name"),
-						Email: safeGetString(assigneeMap, "email"),
-					}
-				}
-
-				issues = append(issues, issue)
-			}
-			
-			project.Issues = issues
+// GetAllProjectIssuesContext is like GetAllProjectIssues but honors ctx for cancellation and deadlines
+func (c *Client) GetAllProjectIssuesContext(ctx context.Context, projectID string, limit int) ([]Issue, error) {
+	return CollectPages(limit, func(after string) (*Page[Issue], error) {
+		project, err := c.GetProjectIssuesContext(ctx, projectID, &GetProjectIssuesOptions{First: maxPageSize, After: after})
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	return project, nil
+		return &Page[Issue]{Nodes: project.Issues, PageInfo: project.PageInfo}, nil
+	})
 }
 
 // UpdateProjectInput represents input for updating an existing project
@@ -491,6 +295,11 @@ type UpdateProjectInput struct {
 
 // UpdateProject updates an existing project in Linear
 func (c *Client) UpdateProject(projectID string, input UpdateProjectInput) (*Project, error) {
+	return c.UpdateProjectContext(context.Background(), projectID, input)
+}
+
+// UpdateProjectContext is like UpdateProject but honors ctx for cancellation and deadlines
+func (c *Client) UpdateProjectContext(ctx context.Context, projectID string, input UpdateProjectInput) (*Project, error) {
 	// Build the input object
 	variables := map[string]interface{}{
 		"id":    projectID,
@@ -536,99 +345,16 @@ func (c *Client) UpdateProject(projectID string, input UpdateProjectInput) (*Pro
 		inputObj["targetDate"] = *input.TargetDate
 	}
 
-	query := `mutation UpdateProject($id: String!, $input: ProjectUpdateInput!) {
-		projectUpdate(id: $id, input: $input) {
-			success
-			project {
-				id
-				name
-				description
-				icon
-				color
-				state
-				createdAt
-				updatedAt
-				startedAt
-				targetDate
-				sortOrder
-				url
-				lead {
-					id
-					name
-					email
-				}
-				teams {
-					nodes {
-						id
-						name
-						key
-					}
-				}
-			}
-		}
-	}`
-
-	resp, err := c.ExecuteGraphQL(query, variables)
+	data, err := queryInto[struct {
+		ProjectUpdate projectPayload `json:"projectUpdate"`
+	}](ctx, c, "update_project.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	projectUpdateData, ok := resp.Data["projectUpdate"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid projectUpdate data format")
-	}
-
-	success, ok := projectUpdateData["success"].(bool)
-	if !ok || !success {
+	if !data.ProjectUpdate.Success || data.ProjectUpdate.Project == nil {
 		return nil, fmt.Errorf("project update was not successful")
 	}
 
-	projectData, ok := projectUpdateData["project"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid project data format")
-	}
-
-	project := &Project{
-		ID:          safeGetString(projectData, "id"),
-		Name:        safeGetString(projectData, "name"),
-		Description: safeGetString(projectData, "description"),
-		Icon:        safeGetString(projectData, "icon"),
-		Color:       safeGetString(projectData, "color"),
-		State:       safeGetString(projectData, "state"),
-		CreatedAt:   safeGetString(projectData, "createdAt"),
-		UpdatedAt:   safeGetString(projectData, "updatedAt"),
-		StartedAt:   safeGetString(projectData, "startedAt"),
-		TargetDate:  safeGetString(projectData, "targetDate"),
-		SortOrder:   safeGetFloat64(projectData, "sortOrder"),
-		URL:         safeGetString(projectData, "url"),
-	}
-
-	if leadMap, ok := projectData["lead"].(map[string]interface{}); ok {
-		project.Lead = &User{
-			ID:    safeGetString(leadMap, "id"),
-			Name:  safeGetString(leadMap, "name"),
-			Email: safeGetString(leadMap, "email"),
-		}
-	}
-
-	if teamsMap, ok := projectData["teams"].(map[string]interface{}); ok {
-		if teamsNodes, ok := teamsMap["nodes"].([]interface{}); ok {
-			teams := make([]Team, 0, len(teamsNodes))
-			for _, teamNode := range teamsNodes {
-				teamMap, ok := teamNode.(map[string]interface{})
-				if !ok {
-					continue
-				}
-				team := Team{
-					ID:   safeGetString(teamMap, "id"),
-					Name: safeGetString(teamMap, "name"),
-					Key:  safeGetString(teamMap, "key"),
-				}
-				teams = append(teams, team)
-			}
-			project.Teams = teams
-		}
-	}
-
-	return project, nil
+	return data.ProjectUpdate.Project.toProject(), nil
 }
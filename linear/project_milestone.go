@@ -0,0 +1,219 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProjectMilestone represents a milestone within a Linear project
+type ProjectMilestone struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	TargetDate  string   `json:"targetDate,omitempty"` // ISO date format
+	SortOrder   float64  `json:"sortOrder,omitempty"`
+	Progress    float64  `json:"progress"` // Completion of the milestone's issues, as reported by Linear
+	Project     *Project `json:"project,omitempty"`
+	CreatedAt   string   `json:"createdAt,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"`
+}
+
+// projectMilestonePayload is the result of a project milestone mutation
+type projectMilestonePayload struct {
+	Success          bool              `json:"success"`
+	ProjectMilestone *ProjectMilestone `json:"projectMilestone"`
+}
+
+// GetProjectMilestonesOptions contains optional parameters for listing project milestones
+type GetProjectMilestonesOptions struct {
+	First int    // Number of milestones to fetch (max 100)
+	After string // Cursor to start fetching after
+}
+
+// GetProjectMilestones returns a page of the milestones in a project
+func (c *Client) GetProjectMilestones(projectID string, opts *GetProjectMilestonesOptions) (*Page[ProjectMilestone], error) {
+	return c.GetProjectMilestonesContext(context.Background(), projectID, opts)
+}
+
+// GetProjectMilestonesContext is like GetProjectMilestones but honors ctx for cancellation and deadlines
+func (c *Client) GetProjectMilestonesContext(ctx context.Context, projectID string, opts *GetProjectMilestonesOptions) (*Page[ProjectMilestone], error) {
+	variables := map[string]interface{}{
+		"id": projectID,
+	}
+
+	if opts == nil {
+		opts = &GetProjectMilestonesOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	data, err := queryInto[struct {
+		Project *struct {
+			ProjectMilestones Page[ProjectMilestone] `json:"projectMilestones"`
+		} `json:"project"`
+	}](ctx, c, "get_project_milestones.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Project == nil {
+		return nil, &NotFoundError{Resource: "project", Ref: projectID}
+	}
+
+	return &data.Project.ProjectMilestones, nil
+}
+
+// GetAllProjectMilestones returns up to limit milestones in a project,
+// following page cursors as needed. A limit of 0 or less returns every milestone.
+func (c *Client) GetAllProjectMilestones(projectID string, limit int) ([]ProjectMilestone, error) {
+	return c.GetAllProjectMilestonesContext(context.Background(), projectID, limit)
+}
+
+// GetAllProjectMilestonesContext is like GetAllProjectMilestones but honors ctx for cancellation and deadlines
+func (c *Client) GetAllProjectMilestonesContext(ctx context.Context, projectID string, limit int) ([]ProjectMilestone, error) {
+	return CollectPages(limit, func(after string) (*Page[ProjectMilestone], error) {
+		return c.GetProjectMilestonesContext(ctx, projectID, &GetProjectMilestonesOptions{First: maxPageSize, After: after})
+	})
+}
+
+// GetProjectMilestone returns a project milestone by ID
+func (c *Client) GetProjectMilestone(milestoneID string) (*ProjectMilestone, error) {
+	return c.GetProjectMilestoneContext(context.Background(), milestoneID)
+}
+
+// GetProjectMilestoneContext is like GetProjectMilestone but honors ctx for cancellation and deadlines
+func (c *Client) GetProjectMilestoneContext(ctx context.Context, milestoneID string) (*ProjectMilestone, error) {
+	variables := map[string]interface{}{
+		"id": milestoneID,
+	}
+
+	data, err := queryInto[struct {
+		ProjectMilestone *ProjectMilestone `json:"projectMilestone"`
+	}](ctx, c, "get_project_milestone.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.ProjectMilestone == nil {
+		return nil, &NotFoundError{Resource: "project milestone", Ref: milestoneID}
+	}
+
+	return data.ProjectMilestone, nil
+}
+
+// CreateProjectMilestoneInput represents input for creating a project milestone
+type CreateProjectMilestoneInput struct {
+	ProjectID   string   `json:"projectId"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	TargetDate  string   `json:"targetDate,omitempty"` // ISO date format
+	SortOrder   *float64 `json:"sortOrder,omitempty"`  // Position among the project's milestones; Linear appends when nil
+}
+
+// CreateProjectMilestone creates a new milestone in a project
+func (c *Client) CreateProjectMilestone(input CreateProjectMilestoneInput) (*ProjectMilestone, error) {
+	return c.CreateProjectMilestoneContext(context.Background(), input)
+}
+
+// CreateProjectMilestoneContext is like CreateProjectMilestone but honors ctx for cancellation and deadlines
+func (c *Client) CreateProjectMilestoneContext(ctx context.Context, input CreateProjectMilestoneInput) (*ProjectMilestone, error) {
+	inputObj := map[string]interface{}{
+		"projectId": input.ProjectID,
+		"name":      input.Name,
+	}
+
+	if input.Description != "" {
+		inputObj["description"] = input.Description
+	}
+
+	if input.TargetDate != "" {
+		inputObj["targetDate"] = input.TargetDate
+	}
+
+	if input.SortOrder != nil {
+		inputObj["sortOrder"] = *input.SortOrder
+	}
+
+	variables := map[string]interface{}{
+		"input": inputObj,
+	}
+
+	data, err := queryInto[struct {
+		ProjectMilestoneCreate projectMilestonePayload `json:"projectMilestoneCreate"`
+	}](ctx, c, "create_project_milestone.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.ProjectMilestoneCreate.Success || data.ProjectMilestoneCreate.ProjectMilestone == nil {
+		return nil, fmt.Errorf("project milestone creation was not successful")
+	}
+
+	return data.ProjectMilestoneCreate.ProjectMilestone, nil
+}
+
+// UpdateProjectMilestoneInput represents input for updating a project milestone
+type UpdateProjectMilestoneInput struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	TargetDate  *string  `json:"targetDate,omitempty"` // ISO date format; an empty string clears the target date
+	SortOrder   *float64 `json:"sortOrder,omitempty"`
+}
+
+// UpdateProjectMilestone updates an existing project milestone
+func (c *Client) UpdateProjectMilestone(milestoneID string, input UpdateProjectMilestoneInput) (*ProjectMilestone, error) {
+	return c.UpdateProjectMilestoneContext(context.Background(), milestoneID, input)
+}
+
+// UpdateProjectMilestoneContext is like UpdateProjectMilestone but honors ctx for cancellation and deadlines
+func (c *Client) UpdateProjectMilestoneContext(ctx context.Context, milestoneID string, input UpdateProjectMilestoneInput) (*ProjectMilestone, error) {
+	inputObj := map[string]interface{}{}
+
+	if input.Name != nil {
+		inputObj["name"] = *input.Name
+	}
+
+	if input.Description != nil {
+		inputObj["description"] = *input.Description
+	}
+
+	if input.TargetDate != nil {
+		if *input.TargetDate == "" {
+			inputObj["targetDate"] = nil
+		} else {
+			inputObj["targetDate"] = *input.TargetDate
+		}
+	}
+
+	if input.SortOrder != nil {
+		inputObj["sortOrder"] = *input.SortOrder
+	}
+
+	variables := map[string]interface{}{
+		"id":    milestoneID,
+		"input": inputObj,
+	}
+
+	data, err := queryInto[struct {
+		ProjectMilestoneUpdate projectMilestonePayload `json:"projectMilestoneUpdate"`
+	}](ctx, c, "update_project_milestone.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.ProjectMilestoneUpdate.Success || data.ProjectMilestoneUpdate.ProjectMilestone == nil {
+		return nil, fmt.Errorf("project milestone update was not successful")
+	}
+
+	return data.ProjectMilestoneUpdate.ProjectMilestone, nil
+}
+
+// DeleteProjectMilestone deletes a project milestone. Its issues stay in the
+// project without a milestone.
+func (c *Client) DeleteProjectMilestone(milestoneID string) error {
+	return c.DeleteProjectMilestoneContext(context.Background(), milestoneID)
+}
+
+// DeleteProjectMilestoneContext is like DeleteProjectMilestone but honors ctx for cancellation and deadlines
+func (c *Client) DeleteProjectMilestoneContext(ctx context.Context, milestoneID string) error {
+	return c.archiveMutation(ctx, "delete_project_milestone.graphql", "projectMilestoneDelete", milestoneID, "project milestone deletion")
+}
@@ -0,0 +1,84 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProjectMilestones(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetProjectMilestones"):
+			w.Write([]byte(`{"data": {"project": {"projectMilestones": {"nodes": [
+				{"id": "m1", "name": "Beta", "targetDate": "2024-06-01", "progress": 0.5},
+				{"id": "m2", "name": "GA", "targetDate": "2024-07-01", "progress": 0}
+			], "pageInfo": {"hasNextPage": false}}}}}`))
+		case strings.HasPrefix(req.Query, "query GetProject"):
+			w.Write([]byte(`{"data": {"project": {"id": "p1", "name": "Launch",
+				"issues": {"nodes": [{"id": "i1", "identifier": "ENG-1", "projectMilestone": {"id": "m1", "name": "Beta"}}]},
+				"projectMilestones": {"nodes": [{"id": "m1", "name": "Beta", "progress": 0.5}]}
+			}}}`))
+		case strings.HasPrefix(req.Query, "mutation UpdateProjectMilestone"):
+			input, _ := json.Marshal(req.Variables["input"])
+			if string(input) != `{"targetDate":null}` {
+				t.Errorf("Expected the target date to be cleared, got %s", input)
+			}
+			w.Write([]byte(`{"data": {"projectMilestoneUpdate": {"success": true, "projectMilestone": {"id": "m1", "name": "Beta"}}}}`))
+		case strings.HasPrefix(req.Query, "mutation UpdateIssue"):
+			input, _ := json.Marshal(req.Variables["input"])
+			if string(input) != `{"projectMilestoneId":null}` {
+				t.Errorf("Expected the issue to be removed from its milestone, got %s", input)
+			}
+			w.Write([]byte(`{"data": {"issueUpdate": {"success": true, "issue": {"id": "i1", "identifier": "ENG-1"}}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	project, err := client.GetProject("p1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(project.Milestones) != 1 || project.Milestones[0].Progress != 0.5 || project.Issues[0].Milestone == nil {
+		t.Errorf("Expected the project's milestones and the issue's milestone, got %+v", project)
+	}
+
+	resolver := NewResolver(client, 0)
+	ctx := context.Background()
+
+	if id, err := resolver.ResolveProjectMilestoneID(ctx, "p1", "beta"); err != nil || id != "m1" {
+		t.Errorf("Expected Beta to resolve to m1, got %q, %v", id, err)
+	}
+	if _, err := resolver.ResolveProjectMilestoneID(ctx, "p1", "RC"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected an unknown milestone to be not found, got %v", err)
+	}
+	if _, err := resolver.ResolveProjectMilestoneID(ctx, "", "Beta"); err == nil {
+		t.Errorf("Expected a milestone name without a project to be rejected")
+	}
+
+	empty := ""
+	if _, err := client.UpdateProjectMilestone("m1", UpdateProjectMilestoneInput{TargetDate: &empty}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := client.UpdateIssue("i1", UpdateIssueInput{MilestoneID: &empty}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
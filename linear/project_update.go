@@ -0,0 +1,288 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Project health values accepted by CreateProjectUpdate
+const (
+	ProjectHealthOnTrack  = "onTrack"
+	ProjectHealthAtRisk   = "atRisk"
+	ProjectHealthOffTrack = "offTrack"
+)
+
+// defaultUpdateWindow is how far back DraftProjectUpdate looks when the
+// project has no earlier update and no start time is given
+const defaultUpdateWindow = 7 * 24 * time.Hour
+
+// draftIssueLimit caps how many project issues DraftProjectUpdate reads
+const draftIssueLimit = 250
+
+// ProjectUpdate represents a status update posted on a Linear project
+type ProjectUpdate struct {
+	ID        string   `json:"id"`
+	Body      string   `json:"body"`   // Markdown
+	Health    string   `json:"health"` // ProjectHealthOnTrack, ProjectHealthAtRisk or ProjectHealthOffTrack
+	User      *User    `json:"user,omitempty"`
+	Project   *Project `json:"project,omitempty"`
+	CreatedAt string   `json:"createdAt"`
+	UpdatedAt string   `json:"updatedAt,omitempty"`
+	EditedAt  string   `json:"editedAt,omitempty"`
+	URL       string   `json:"url,omitempty"`
+}
+
+// projectUpdatePayload is the result of a project update mutation
+type projectUpdatePayload struct {
+	Success       bool           `json:"success"`
+	ProjectUpdate *ProjectUpdate `json:"projectUpdate"`
+}
+
+// GetProjectUpdatesOptions contains optional parameters for listing project updates
+type GetProjectUpdatesOptions struct {
+	First int    // Number of updates to fetch (max 100)
+	After string // Cursor to start fetching after
+}
+
+// GetProjectUpdates returns a page of the status updates posted on a
+// project, newest first
+func (c *Client) GetProjectUpdates(projectID string, opts *GetProjectUpdatesOptions) (*Page[ProjectUpdate], error) {
+	return c.GetProjectUpdatesContext(context.Background(), projectID, opts)
+}
+
+// GetProjectUpdatesContext is like GetProjectUpdates but honors ctx for cancellation and deadlines
+func (c *Client) GetProjectUpdatesContext(ctx context.Context, projectID string, opts *GetProjectUpdatesOptions) (*Page[ProjectUpdate], error) {
+	variables := map[string]interface{}{
+		"id": projectID,
+	}
+
+	if opts == nil {
+		opts = &GetProjectUpdatesOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	data, err := queryInto[struct {
+		Project *struct {
+			ProjectUpdates Page[ProjectUpdate] `json:"projectUpdates"`
+		} `json:"project"`
+	}](ctx, c, "get_project_updates.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Project == nil {
+		return nil, &NotFoundError{Resource: "project", Ref: projectID}
+	}
+
+	return &data.Project.ProjectUpdates, nil
+}
+
+// CreateProjectUpdateInput represents input for posting a project update
+type CreateProjectUpdateInput struct {
+	ProjectID string `json:"projectId"`
+	Body      string `json:"body"`             // Markdown
+	Health    string `json:"health,omitempty"` // ProjectHealthOnTrack, ProjectHealthAtRisk or ProjectHealthOffTrack
+}
+
+// CreateProjectUpdate posts a status update on a project
+func (c *Client) CreateProjectUpdate(input CreateProjectUpdateInput) (*ProjectUpdate, error) {
+	return c.CreateProjectUpdateContext(context.Background(), input)
+}
+
+// CreateProjectUpdateContext is like CreateProjectUpdate but honors ctx for cancellation and deadlines
+func (c *Client) CreateProjectUpdateContext(ctx context.Context, input CreateProjectUpdateInput) (*ProjectUpdate, error) {
+	inputObj := map[string]interface{}{
+		"projectId": input.ProjectID,
+		"body":      input.Body,
+	}
+
+	if input.Health != "" {
+		inputObj["health"] = input.Health
+	}
+
+	variables := map[string]interface{}{
+		"input": inputObj,
+	}
+
+	data, err := queryInto[struct {
+		ProjectUpdateCreate projectUpdatePayload `json:"projectUpdateCreate"`
+	}](ctx, c, "create_project_update.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.ProjectUpdateCreate.Success || data.ProjectUpdateCreate.ProjectUpdate == nil {
+		return nil, fmt.Errorf("project update creation was not successful")
+	}
+
+	return data.ProjectUpdateCreate.ProjectUpdate, nil
+}
+
+// DraftProjectUpdateOptions contains optional parameters for DraftProjectUpdate
+type DraftProjectUpdateOptions struct {
+	Since string // Summarize activity after this RFC 3339 time or YYYY-MM-DD date; defaults to the last update, or a week ago
+}
+
+// ProjectUpdateDraft is a project update written from recent issue activity,
+// ready to be reviewed and posted with CreateProjectUpdate
+type ProjectUpdateDraft struct {
+	ProjectID  string  `json:"projectId"`
+	Since      string  `json:"since"`  // Start of the summarized activity, RFC 3339
+	Health     string  `json:"health"` // Suggested health: at risk when open issues are overdue, otherwise on track
+	Body       string  `json:"body"`   // Markdown
+	Completed  []Issue `json:"completed"`
+	InProgress []Issue `json:"inProgress"`
+	Added      []Issue `json:"added"`
+	Overdue    []Issue `json:"overdue"`
+	Truncated  bool    `json:"truncated,omitempty"` // Set when the project had more issues than were read
+}
+
+// DraftProjectUpdate writes a project update from the project's issues:
+// those completed and added since the last update, those in progress and
+// those overdue. Nothing is posted.
+func (c *Client) DraftProjectUpdate(projectID string, opts *DraftProjectUpdateOptions) (*ProjectUpdateDraft, error) {
+	return c.DraftProjectUpdateContext(context.Background(), projectID, opts)
+}
+
+// DraftProjectUpdateContext is like DraftProjectUpdate but honors ctx for cancellation and deadlines
+func (c *Client) DraftProjectUpdateContext(ctx context.Context, projectID string, opts *DraftProjectUpdateOptions) (*ProjectUpdateDraft, error) {
+	now := time.Now().UTC()
+
+	since := now.Add(-defaultUpdateWindow)
+	if opts != nil && opts.Since != "" {
+		var err error
+		if since, err = parseSince(opts.Since); err != nil {
+			return nil, err
+		}
+	} else {
+		updates, err := c.GetProjectUpdatesContext(ctx, projectID, &GetProjectUpdatesOptions{First: 1})
+		if err != nil {
+			return nil, err
+		}
+		if len(updates.Nodes) > 0 {
+			if last, err := time.Parse(time.RFC3339, updates.Nodes[0].CreatedAt); err == nil {
+				since = last
+			}
+		}
+	}
+
+	// Fetching one extra issue tells us whether the limit cut the list short
+	issues, err := c.GetAllProjectIssuesContext(ctx, projectID, draftIssueLimit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := &ProjectUpdateDraft{
+		ProjectID:  projectID,
+		Since:      since.Format(time.RFC3339),
+		Health:     ProjectHealthOnTrack,
+		Completed:  []Issue{},
+		InProgress: []Issue{},
+		Added:      []Issue{},
+		Overdue:    []Issue{},
+	}
+	if len(issues) > draftIssueLimit {
+		issues = issues[:draftIssueLimit]
+		draft.Truncated = true
+	}
+
+	today := now.Format(time.DateOnly)
+	done, counted := 0, 0
+	for _, issue := range issues {
+		stateType := ""
+		if issue.State != nil {
+			stateType = issue.State.Type
+		}
+		if stateType != StateTypeCanceled {
+			counted++
+		}
+
+		switch stateType {
+		case StateTypeCompleted:
+			done++
+			if isAfter(issue.CompletedAt, since) {
+				draft.Completed = append(draft.Completed, issue)
+			}
+		case StateTypeStarted:
+			draft.InProgress = append(draft.InProgress, issue)
+		}
+
+		if isDone(issue.State) {
+			continue
+		}
+		if isAfter(issue.CreatedAt, since) {
+			draft.Added = append(draft.Added, issue)
+		}
+		if issue.DueDate != "" && issue.DueDate < today {
+			draft.Overdue = append(draft.Overdue, issue)
+		}
+	}
+
+	if len(draft.Overdue) > 0 {
+		draft.Health = ProjectHealthAtRisk
+	}
+
+	draft.Body = draft.markdown(since, done, counted)
+	return draft, nil
+}
+
+// markdown renders the draft body, listing issues under one heading per kind
+// of activity
+func (d *ProjectUpdateDraft) markdown(since time.Time, done, counted int) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Since %s: %d completed, %d in progress, %d added", since.Format("Jan 2"), len(d.Completed), len(d.InProgress), len(d.Added))
+	if len(d.Overdue) > 0 {
+		fmt.Fprintf(&b, ", %d overdue", len(d.Overdue))
+	}
+	fmt.Fprintf(&b, ". %d of %d issues are done overall.\n", done, counted)
+
+	sections := []struct {
+		title  string
+		issues []Issue
+	}{
+		{"Completed", d.Completed},
+		{"In progress", d.InProgress},
+		{"Added", d.Added},
+		{"Overdue", d.Overdue},
+	}
+	for _, section := range sections {
+		if len(section.issues) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n### %s\n", section.title)
+		for _, issue := range section.issues {
+			fmt.Fprintf(&b, "- %s %s", issue.Identifier, issue.Title)
+			if issue.Assignee != nil {
+				fmt.Fprintf(&b, " (%s)", issue.Assignee.Name)
+			}
+			if section.title == "Overdue" {
+				fmt.Fprintf(&b, ", due %s", issue.DueDate)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// parseSince parses an RFC 3339 time or a YYYY-MM-DD date taken as
+// midnight UTC
+func parseSince(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected an RFC 3339 time or a YYYY-MM-DD date", value)
+}
+
+// isAfter reports whether timestamp, an RFC 3339 time from the API, is after t
+func isAfter(timestamp string, t time.Time) bool {
+	parsed, err := time.Parse(time.RFC3339, timestamp)
+	return err == nil && parsed.After(t)
+}
@@ -0,0 +1,79 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDraftProjectUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetProjectUpdates"):
+			w.Write([]byte(`{"data": {"project": {"projectUpdates": {"nodes": [
+				{"id": "u1", "body": "Kickoff", "health": "onTrack", "createdAt": "2024-05-01T00:00:00.000Z"}
+			], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}}`))
+		case strings.HasPrefix(req.Query, "query GetProjectIssues"):
+			w.Write([]byte(`{"data": {"project": {"id": "p1", "issues": {"nodes": [
+				{"id": "i1", "identifier": "ENG-1", "title": "Old work", "state": {"type": "completed"}, "createdAt": "2024-04-01T00:00:00.000Z", "updatedAt": "2024-05-04T00:00:00.000Z", "completedAt": "2024-04-20T00:00:00.000Z"},
+				{"id": "i2", "identifier": "ENG-2", "title": "Login page", "state": {"type": "completed"}, "createdAt": "2024-04-01T00:00:00.000Z", "updatedAt": "2024-05-03T00:00:00.000Z", "completedAt": "2024-05-03T00:00:00.000Z"},
+				{"id": "i3", "identifier": "ENG-3", "title": "Signup flow", "state": {"type": "started"}, "assignee": {"id": "u1", "name": "Ada"}, "createdAt": "2024-05-02T00:00:00.000Z", "dueDate": "2000-01-01"},
+				{"id": "i4", "identifier": "ENG-4", "title": "Dropped", "state": {"type": "canceled"}, "createdAt": "2024-05-02T00:00:00.000Z"}
+			], "pageInfo": {"hasNextPage": false}}}}}`))
+		case strings.HasPrefix(req.Query, "mutation CreateProjectUpdate"):
+			input, _ := json.Marshal(req.Variables["input"])
+			if string(input) != `{"body":"Shipped login","health":"atRisk","projectId":"p1"}` {
+				t.Errorf("Unexpected project update input: %s", input)
+			}
+			w.Write([]byte(`{"data": {"projectUpdateCreate": {"success": true, "projectUpdate": {"id": "u2", "body": "Shipped login", "health": "atRisk"}}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	draft, err := client.DraftProjectUpdate("p1", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if draft.Since != "2024-05-01T00:00:00Z" {
+		t.Errorf("Expected the draft to start at the last update, got %s", draft.Since)
+	}
+	if len(draft.Completed) != 1 || draft.Completed[0].ID != "i2" || len(draft.Added) != 1 || len(draft.Overdue) != 1 {
+		t.Errorf("Expected only ENG-2 completed, not ENG-1 edited after completion, and ENG-3 added and overdue, got %+v", draft)
+	}
+	if draft.Health != ProjectHealthAtRisk {
+		t.Errorf("Expected an overdue issue to suggest at risk, got %s", draft.Health)
+	}
+	for _, want := range []string{"1 completed, 1 in progress, 1 added, 1 overdue", "2 of 3 issues are done", "- ENG-3 Signup flow (Ada), due 2000-01-01"} {
+		if !strings.Contains(draft.Body, want) {
+			t.Errorf("Expected the body to contain %q, got:\n%s", want, draft.Body)
+		}
+	}
+
+	update, err := client.CreateProjectUpdate(CreateProjectUpdateInput{ProjectID: "p1", Body: "Shipped login", Health: ProjectHealthAtRisk})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if update.ID != "u2" {
+		t.Errorf("Expected the posted update, got %+v", update)
+	}
+
+	if _, err := client.DraftProjectUpdate("p1", &DraftProjectUpdateOptions{Since: "last week"}); err == nil {
+		t.Errorf("Expected an unparseable start time to be rejected")
+	}
+}
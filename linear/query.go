@@ -0,0 +1,412 @@
+package linear
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseIssueQuery parses a compact issue query into search options, e.g.
+//
+//	team:ENG state:started assignee:me label:bug priority:<=2 updated:>-7d "login crash"
+//
+// Words and quoted phrases without a key become the full-text search term.
+// Keyed filters are:
+//
+//	team:ENG              team key, name or ID
+//	state:started         state type (e.g. todo, started, done) or state name
+//	assignee:me           user reference, or "none" for unassigned issues
+//	creator:ada@acme.com  user reference
+//	label:bug             label name or ID; repeated labels must all match
+//	priority:<=2          priority number or name, optionally with <, <=, > or >=
+//	project:"Q3 Launch"   project name, slug ID or ID
+//	cycle:current         cycle number, ID, or current/next/previous
+//	parent:ENG-12         parent issue, or "none"/"any"
+//	has:parent no:parent no:assignee
+//	created:>-2w updated:<2024-06-01 due:2024-06-01..2024-06-30
+//	sort:updated          sort by created or updated
+//
+// Comma-separated values (e.g. state:todo,started) match any of them, except
+// for labels. Team, user, project and parent references are left as given;
+// resolve them with Resolver.ResolveIssueFilter before searching.
+func ParseIssueQuery(query string) (*SearchIssuesOptions, error) {
+	tokens, err := lexIssueQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{
+		query:  query,
+		filter: &IssueFilter{},
+	}
+	for _, token := range tokens {
+		if err := p.apply(token); err != nil {
+			return nil, err
+		}
+	}
+
+	return &SearchIssuesOptions{
+		Term:    strings.Join(p.terms, " "),
+		Filter:  p.filter,
+		OrderBy: p.orderBy,
+	}, nil
+}
+
+// queryKeys lists the filter keys accepted by ParseIssueQuery, for error messages
+var queryKeys = []string{"team", "state", "assignee", "creator", "label", "priority", "project", "cycle", "parent", "has", "no", "created", "updated", "due", "sort"}
+
+// queryToken is a single key:value filter or free-text term in a query
+type queryToken struct {
+	key    string // Lowercased key, empty for free text
+	value  string // Value with any quotes removed
+	text   string // Token as written, for error messages
+	offset int    // Byte offset of the token in the query
+}
+
+// lexIssueQuery splits a query into tokens, honoring double-quoted values
+func lexIssueQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+
+	i := 0
+	for i < len(query) {
+		if query[i] == ' ' || query[i] == '\t' || query[i] == '\n' {
+			i++
+			continue
+		}
+
+		start := i
+		token := queryToken{offset: start}
+
+		if query[i] != '"' {
+			for i < len(query) && query[i] != ':' && query[i] != ' ' && query[i] != '"' && query[i] != '\t' && query[i] != '\n' {
+				i++
+			}
+
+			if i < len(query) && query[i] == ':' {
+				token.key = strings.ToLower(query[start:i])
+				i++
+			} else {
+				// A bare word is free text
+				token.value = query[start:i]
+				token.text = token.value
+				tokens = append(tokens, token)
+				continue
+			}
+		}
+
+		if i < len(query) && query[i] == '"' {
+			end := strings.IndexByte(query[i+1:], '"')
+			if end < 0 {
+				return nil, &QueryError{Query: query, Offset: start, Token: query[start:], Message: "unterminated quote"}
+			}
+			token.value = query[i+1 : i+1+end]
+			i += end + 2
+		} else {
+			valueStart := i
+			for i < len(query) && query[i] != ' ' && query[i] != '\t' && query[i] != '\n' {
+				i++
+			}
+			token.value = query[valueStart:i]
+		}
+
+		token.text = query[start:i]
+		if token.key != "" && token.value == "" {
+			return nil, &QueryError{Query: query, Offset: start, Token: token.text, Message: fmt.Sprintf("missing value after %s:", token.key)}
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+// queryParser accumulates parsed tokens into search options
+type queryParser struct {
+	query   string
+	filter  *IssueFilter
+	terms   []string
+	orderBy string
+}
+
+// errorf returns a QueryError pointing at token
+func (p *queryParser) errorf(token queryToken, format string, args ...interface{}) error {
+	return &QueryError{Query: p.query, Offset: token.offset, Token: token.text, Message: fmt.Sprintf(format, args...)}
+}
+
+// apply adds a single token to the filter
+func (p *queryParser) apply(token queryToken) error {
+	f := p.filter
+	values := splitQueryValues(token.value)
+
+	switch token.key {
+	case "":
+		if token.value != "" {
+			p.terms = append(p.terms, token.value)
+		}
+
+	case "team":
+		f.TeamIDs = append(f.TeamIDs, values...)
+
+	case "state", "status":
+		for _, value := range values {
+			if stateType, ok := stateTypeAliases[normalizeStateRef(value)]; ok {
+				f.StateTypes = append(f.StateTypes, stateType)
+			} else {
+				f.StateNames = append(f.StateNames, value)
+			}
+		}
+
+	case "assignee":
+		for _, value := range values {
+			switch strings.ToLower(value) {
+			case "none", "unassigned":
+				f.Unassigned = true
+			default:
+				f.AssigneeIDs = append(f.AssigneeIDs, value)
+			}
+		}
+		if f.Unassigned && len(f.AssigneeIDs) > 0 {
+			return p.errorf(token, "cannot match unassigned issues and specific assignees at once")
+		}
+
+	case "creator", "author":
+		f.CreatorIDs = append(f.CreatorIDs, values...)
+
+	case "label", "labels":
+		f.Labels = append(f.Labels, values...)
+
+	case "priority":
+		for _, value := range values {
+			priorities, err := parsePriorities(value)
+			if err != nil {
+				return p.errorf(token, "%v", err)
+			}
+			f.Priorities = mergePriorities(f.Priorities, priorities)
+		}
+
+	case "project":
+		f.ProjectIDs = append(f.ProjectIDs, values...)
+
+	case "cycle", "sprint":
+		if f.Cycle != "" {
+			return p.errorf(token, "only one cycle can be given")
+		}
+		f.Cycle = token.value
+
+	case "parent":
+		switch strings.ToLower(token.value) {
+		case "none":
+			return p.setHasParent(token, false)
+		case "any":
+			return p.setHasParent(token, true)
+		}
+		if f.ParentID != "" {
+			return p.errorf(token, "only one parent can be given")
+		}
+		f.ParentID = token.value
+
+	case "has", "no":
+		switch strings.ToLower(token.value) {
+		case "parent":
+			return p.setHasParent(token, token.key == "has")
+		case "assignee":
+			if token.key == "has" {
+				return p.errorf(token, "use no:assignee or assignee:<user>")
+			}
+			f.Unassigned = true
+		default:
+			return p.errorf(token, "unknown %s: value; valid values are parent and assignee", token.key)
+		}
+
+	case "created", "updated", "due":
+		after, before, err := parseDateRange(token.value)
+		if err != nil {
+			return p.errorf(token, "%v", err)
+		}
+		switch token.key {
+		case "created":
+			f.CreatedAfter, f.CreatedBefore = orDefault(after, f.CreatedAfter), orDefault(before, f.CreatedBefore)
+		case "updated":
+			f.UpdatedAfter, f.UpdatedBefore = orDefault(after, f.UpdatedAfter), orDefault(before, f.UpdatedBefore)
+		case "due":
+			f.DueAfter, f.DueBefore = orDefault(after, f.DueAfter), orDefault(before, f.DueBefore)
+		}
+
+	case "sort", "order":
+		switch strings.TrimSuffix(strings.ToLower(token.value), "at") {
+		case "created":
+			p.orderBy = OrderByCreatedAt
+		case "updated":
+			p.orderBy = OrderByUpdatedAt
+		default:
+			return p.errorf(token, "can only sort by created or updated")
+		}
+
+	default:
+		return p.errorf(token, "unknown filter %q; valid filters are %s, or quote the text to search for it", token.key, strings.Join(queryKeys, ", "))
+	}
+
+	return nil
+}
+
+// setHasParent restricts the search to sub-issues or top-level issues
+func (p *queryParser) setHasParent(token queryToken, hasParent bool) error {
+	if p.filter.HasParent != nil && *p.filter.HasParent != hasParent {
+		return p.errorf(token, "conflicts with an earlier parent filter")
+	}
+	p.filter.HasParent = &hasParent
+	return nil
+}
+
+// splitQueryValues splits a comma-separated value, dropping empty entries
+func splitQueryValues(value string) []string {
+	var values []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
+
+// orDefault returns value, or fallback when value is empty
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// priorityNames maps priority names to Linear's priority numbers
+var priorityNames = map[string]int{
+	"none":   0,
+	"urgent": 1,
+	"high":   2,
+	"medium": 3,
+	"normal": 3,
+	"low":    4,
+}
+
+// parsePriorities parses a priority such as "2", "high" or "<=2" into the set
+// of matching priorities. Comparisons only match set priorities (1 to 4).
+func parsePriorities(value string) ([]int, error) {
+	op := ""
+	for _, candidate := range []string{"<=", ">=", "<", ">"} {
+		if strings.HasPrefix(value, candidate) {
+			op = candidate
+			break
+		}
+	}
+
+	ref := strings.ToLower(strings.TrimPrefix(value, op))
+	priority, ok := priorityNames[ref]
+	if !ok {
+		n, err := strconv.Atoi(ref)
+		if err != nil || n < 0 || n > 4 {
+			return nil, fmt.Errorf("priority must be 0-4 or one of none, urgent, high, medium, low")
+		}
+		priority = n
+	}
+
+	if op == "" {
+		return []int{priority}, nil
+	}
+
+	var priorities []int
+	for p := 1; p <= 4; p++ {
+		if (op == "<" && p < priority) || (op == "<=" && p <= priority) || (op == ">" && p > priority) || (op == ">=" && p >= priority) {
+			priorities = append(priorities, p)
+		}
+	}
+
+	if len(priorities) == 0 {
+		return nil, fmt.Errorf("priority %s matches no priorities", value)
+	}
+	return priorities, nil
+}
+
+// mergePriorities returns the sorted union of two priority sets
+func mergePriorities(a, b []int) []int {
+	seen := map[int]bool{}
+	var merged []int
+	for _, p := range append(append([]int{}, a...), b...) {
+		if !seen[p] {
+			seen[p] = true
+			merged = append(merged, p)
+		}
+	}
+	sort.Ints(merged)
+	return merged
+}
+
+var (
+	relativeDateRe = regexp.MustCompile(`^([+-]?)(\d+)([hdwmy])$`)
+	isoDurationRe  = regexp.MustCompile(`^-?P(\d+[YMWD])*(T(\d+[HMS])+)?$`)
+)
+
+// parseDateRange parses a date comparison such as ">-7d", "<=2024-06-01" or
+// "2024-05-01..2024-05-31" into inclusive after and before bounds
+func parseDateRange(value string) (after, before string, err error) {
+	if from, to, ok := strings.Cut(value, ".."); ok {
+		if from == "" && to == "" {
+			return "", "", fmt.Errorf("date range needs at least one bound")
+		}
+		if from != "" {
+			if after, err = parseDateBound(from); err != nil {
+				return "", "", err
+			}
+		}
+		if to != "" {
+			if before, err = parseDateBound(to); err != nil {
+				return "", "", err
+			}
+		}
+		return after, before, nil
+	}
+
+	switch {
+	case strings.HasPrefix(value, ">"):
+		after, err = parseDateBound(strings.TrimLeft(value[1:], "="))
+		return after, "", err
+	case strings.HasPrefix(value, "<"):
+		before, err = parseDateBound(strings.TrimLeft(value[1:], "="))
+		return "", before, err
+	}
+
+	return "", "", fmt.Errorf("dates need a comparison such as >-7d or <2024-06-01, or a range such as 2024-05-01..2024-05-31")
+}
+
+// parseDateBound converts a relative date such as "-7d" into an ISO 8601
+// duration, and validates absolute dates and durations
+func parseDateBound(value string) (string, error) {
+	if match := relativeDateRe.FindStringSubmatch(value); match != nil {
+		sign := ""
+		if match[1] == "-" {
+			sign = "-"
+		}
+
+		switch match[3] {
+		case "h":
+			return fmt.Sprintf("%sPT%sH", sign, match[2]), nil
+		default:
+			return fmt.Sprintf("%sP%s%s", sign, match[2], strings.ToUpper(match[3])), nil
+		}
+	}
+
+	if isoDurationRe.MatchString(value) && value != "P" && value != "-P" {
+		return value, nil
+	}
+
+	if _, err := time.Parse("2006-01-02", value); err == nil {
+		return value, nil
+	}
+
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return value, nil
+	}
+
+	return "", fmt.Errorf("%q is not a date; use YYYY-MM-DD or a relative time such as -7d, -2w, -3m or 12h", value)
+}
@@ -0,0 +1,74 @@
+package linear
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseIssueQuery(t *testing.T) {
+	opts, err := ParseIssueQuery(`team:ENG state:started state:"In Review" assignee:me label:bug label:"needs design" priority:<=2 updated:>-7d due:2024-06-01..2024-06-30 no:parent sort:updated "login crash" safari`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if opts.Term != "login crash safari" {
+		t.Errorf("Expected term %q, got %q", "login crash safari", opts.Term)
+	}
+
+	if opts.OrderBy != OrderByUpdatedAt {
+		t.Errorf("Expected order by updatedAt, got %q", opts.OrderBy)
+	}
+
+	hasParent := false
+	want := &IssueFilter{
+		TeamIDs:      []string{"ENG"},
+		StateTypes:   []string{StateTypeStarted},
+		StateNames:   []string{"In Review"},
+		AssigneeIDs:  []string{"me"},
+		Labels:       []string{"bug", "needs design"},
+		Priorities:   []int{1, 2},
+		HasParent:    &hasParent,
+		UpdatedAfter: "-P7D",
+		DueAfter:     "2024-06-01",
+		DueBefore:    "2024-06-30",
+	}
+	if !reflect.DeepEqual(opts.Filter, want) {
+		t.Errorf("Unexpected filter:\n got: %+v\nwant: %+v", opts.Filter, want)
+	}
+}
+
+func TestParseIssueQueryErrors(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantOffset int
+		wantToken  string
+	}{
+		{query: `state:done colour:red`, wantOffset: 11, wantToken: "colour:red"},
+		{query: `team:ENG priority:5`, wantOffset: 9, wantToken: "priority:5"},
+		{query: `updated:yesterday`, wantOffset: 0, wantToken: "updated:yesterday"},
+		{query: `created:2024-05-01`, wantOffset: 0, wantToken: "created:2024-05-01"},
+		{query: `bug label:"needs design`, wantOffset: 4, wantToken: `label:"needs design`},
+		{query: `team: ENG`, wantOffset: 0, wantToken: "team:"},
+		{query: `assignee:none,me`, wantOffset: 0, wantToken: "assignee:none,me"},
+		{query: `has:parent no:parent`, wantOffset: 11, wantToken: "no:parent"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseIssueQuery(tt.query)
+
+		var queryErr *QueryError
+		if !errors.As(err, &queryErr) {
+			t.Errorf("ParseIssueQuery(%q): expected a QueryError, got %v", tt.query, err)
+			continue
+		}
+
+		if queryErr.Offset != tt.wantOffset || queryErr.Token != tt.wantToken {
+			t.Errorf("ParseIssueQuery(%q): expected error at %d near %q, got %d near %q", tt.query, tt.wantOffset, tt.wantToken, queryErr.Offset, queryErr.Token)
+		}
+
+		if !errors.Is(err, ErrValidation) {
+			t.Errorf("ParseIssueQuery(%q): expected error to be ErrValidation", tt.query)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+)
+
+// Issue relation types, as stored by Linear
+const (
+	RelationBlocks    = "blocks"
+	RelationDuplicate = "duplicate"
+	RelationRelated   = "related"
+
+	// RelationBlockedBy is accepted by CreateIssueRelation and creates a
+	// "blocks" relation in the opposite direction
+	RelationBlockedBy = "blocked_by"
+)
+
+// Relation kinds, describing a relation from the point of view of one issue
+const (
+	RelationKindBlocks       = "blocks"
+	RelationKindBlockedBy    = "blocked_by"
+	RelationKindDuplicateOf  = "duplicate_of"
+	RelationKindDuplicatedBy = "duplicated_by"
+	RelationKindRelated      = "related"
+)
+
+// IssueRelation represents a relation between two Linear issues, such as
+// Issue blocking RelatedIssue
+type IssueRelation struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`           // blocks, duplicate or related
+	Kind         string `json:"kind,omitempty"` // The relation as seen from the issue it was listed for, e.g. blocked_by
+	Issue        *Issue `json:"issue,omitempty"`
+	RelatedIssue *Issue `json:"relatedIssue,omitempty"`
+}
+
+// Other returns the issue on the other side of the relation from issueID
+func (r *IssueRelation) Other(issueID string) *Issue {
+	if r.Issue != nil && r.Issue.ID == issueID {
+		return r.RelatedIssue
+	}
+	return r.Issue
+}
+
+// relationKind describes a relation of type relationType from the point of
+// view of its issue (outgoing) or its related issue (incoming)
+func relationKind(relationType string, outgoing bool) string {
+	switch relationType {
+	case RelationBlocks:
+		if outgoing {
+			return RelationKindBlocks
+		}
+		return RelationKindBlockedBy
+	case RelationDuplicate:
+		if outgoing {
+			return RelationKindDuplicateOf
+		}
+		return RelationKindDuplicatedBy
+	case RelationRelated:
+		return RelationKindRelated
+	}
+	return relationType
+}
+
+// GetIssueRelations returns every relation of an issue in both directions,
+// with Kind describing each relation from the issue's point of view
+func (c *Client) GetIssueRelations(issueID string) ([]IssueRelation, error) {
+	return c.GetIssueRelationsContext(context.Background(), issueID)
+}
+
+// GetIssueRelationsContext is like GetIssueRelations but honors ctx for cancellation and deadlines
+func (c *Client) GetIssueRelationsContext(ctx context.Context, issueID string) ([]IssueRelation, error) {
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": maxPageSize,
+	}
+
+	data, err := queryInto[struct {
+		Issue *struct {
+			Relations        Page[IssueRelation] `json:"relations"`
+			InverseRelations Page[IssueRelation] `json:"inverseRelations"`
+		} `json:"issue"`
+	}](ctx, c, "get_issue_relations.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Issue == nil {
+		return nil, &NotFoundError{Resource: "issue", Ref: issueID}
+	}
+
+	relations := make([]IssueRelation, 0, len(data.Issue.Relations.Nodes)+len(data.Issue.InverseRelations.Nodes))
+	for _, relation := range data.Issue.Relations.Nodes {
+		relation.Kind = relationKind(relation.Type, true)
+		relations = append(relations, relation)
+	}
+	for _, relation := range data.Issue.InverseRelations.Nodes {
+		relation.Kind = relationKind(relation.Type, false)
+		relations = append(relations, relation)
+	}
+
+	return relations, nil
+}
+
+// CreateIssueRelationInput represents input for creating an issue relation
+type CreateIssueRelationInput struct {
+	IssueID        string `json:"issueId"`
+	RelatedIssueID string `json:"relatedIssueId"`
+	Type           string `json:"type"` // blocks, blocked_by, duplicate or related
+}
+
+// CreateIssueRelation relates two issues, e.g. IssueID blocks RelatedIssueID
+func (c *Client) CreateIssueRelation(input CreateIssueRelationInput) (*IssueRelation, error) {
+	return c.CreateIssueRelationContext(context.Background(), input)
+}
+
+// CreateIssueRelationContext is like CreateIssueRelation but honors ctx for cancellation and deadlines
+func (c *Client) CreateIssueRelationContext(ctx context.Context, input CreateIssueRelationInput) (*IssueRelation, error) {
+	issueID, relatedIssueID, relationType := input.IssueID, input.RelatedIssueID, input.Type
+
+	// Linear only stores the blocking side, so flip blocked_by around
+	if relationType == RelationBlockedBy {
+		issueID, relatedIssueID, relationType = relatedIssueID, issueID, RelationBlocks
+	}
+
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":        issueID,
+			"relatedIssueId": relatedIssueID,
+			"type":           relationType,
+		},
+	}
+
+	data, err := queryInto[struct {
+		IssueRelationCreate struct {
+			Success       bool           `json:"success"`
+			IssueRelation *IssueRelation `json:"issueRelation"`
+		} `json:"issueRelationCreate"`
+	}](ctx, c, "create_issue_relation.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	relation := data.IssueRelationCreate.IssueRelation
+	if !data.IssueRelationCreate.Success || relation == nil {
+		return nil, fmt.Errorf("issue relation creation was not successful")
+	}
+
+	relation.Kind = relationKind(relation.Type, input.Type != RelationBlockedBy)
+	return relation, nil
+}
+
+// DeleteIssueRelation deletes an issue relation
+func (c *Client) DeleteIssueRelation(relationID string) error {
+	return c.DeleteIssueRelationContext(context.Background(), relationID)
+}
+
+// DeleteIssueRelationContext is like DeleteIssueRelation but honors ctx for cancellation and deadlines
+func (c *Client) DeleteIssueRelationContext(ctx context.Context, relationID string) error {
+	return c.archiveMutation(ctx, "delete_issue_relation.graphql", "issueRelationDelete", relationID, "issue relation deletion")
+}
+
+// MarkAsDuplicate marks an issue as a duplicate of another issue
+func (c *Client) MarkAsDuplicate(issueID, duplicateOfID string) (*IssueRelation, error) {
+	return c.MarkAsDuplicateContext(context.Background(), issueID, duplicateOfID)
+}
+
+// MarkAsDuplicateContext is like MarkAsDuplicate but honors ctx for cancellation and deadlines
+func (c *Client) MarkAsDuplicateContext(ctx context.Context, issueID, duplicateOfID string) (*IssueRelation, error) {
+	return c.CreateIssueRelationContext(ctx, CreateIssueRelationInput{
+		IssueID:        issueID,
+		RelatedIssueID: duplicateOfID,
+		Type:           RelationDuplicate,
+	})
+}
@@ -0,0 +1,82 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIssueRelations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetIssueRelations"):
+			w.Write([]byte(`{"data": {"issue": {
+				"relations": {"nodes": [
+					{"id": "rel1", "type": "blocks", "issue": {"id": "issue12", "identifier": "ENG-12"}, "relatedIssue": {"id": "issue40", "identifier": "ENG-40"}}
+				]},
+				"inverseRelations": {"nodes": [
+					{"id": "rel2", "type": "blocks", "issue": {"id": "issue7", "identifier": "ENG-7"}, "relatedIssue": {"id": "issue12", "identifier": "ENG-12"}},
+					{"id": "rel3", "type": "duplicate", "issue": {"id": "issue50", "identifier": "ENG-50"}, "relatedIssue": {"id": "issue12", "identifier": "ENG-12"}}
+				]}
+			}}}`))
+		case strings.HasPrefix(req.Query, "mutation CreateIssueRelation"):
+			input := req.Variables["input"].(map[string]interface{})
+			if input["issueId"] != "issue40" || input["relatedIssueId"] != "issue12" || input["type"] != RelationBlocks {
+				t.Errorf("Expected blocked_by to be sent as issue40 blocks issue12, got %v", input)
+			}
+
+			w.Write([]byte(`{"data": {"issueRelationCreate": {"success": true, "issueRelation":
+				{"id": "rel4", "type": "blocks", "issue": {"id": "issue40"}, "relatedIssue": {"id": "issue12"}}
+			}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	relations, err := client.GetIssueRelations("issue12")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantKinds := []string{RelationKindBlocks, RelationKindBlockedBy, RelationKindDuplicatedBy}
+	if len(relations) != len(wantKinds) {
+		t.Fatalf("Expected %d relations, got %d", len(wantKinds), len(relations))
+	}
+
+	for i, relation := range relations {
+		if relation.Kind != wantKinds[i] {
+			t.Errorf("Expected relation %s to be %s, got %s", relation.ID, wantKinds[i], relation.Kind)
+		}
+	}
+
+	if other := relations[1].Other("issue12"); other == nil || other.Identifier != "ENG-7" {
+		t.Errorf("Expected ENG-12 to be blocked by ENG-7, got %+v", other)
+	}
+
+	relation, err := client.CreateIssueRelation(CreateIssueRelationInput{
+		IssueID:        "issue12",
+		RelatedIssueID: "issue40",
+		Type:           RelationBlockedBy,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if relation.Kind != RelationKindBlockedBy {
+		t.Errorf("Expected created relation to read as blocked_by, got %s", relation.Kind)
+	}
+}
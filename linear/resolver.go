@@ -0,0 +1,568 @@
+package linear
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultResolverTTL is how long a Resolver caches lookups by default
+const DefaultResolverTTL = 5 * time.Minute
+
+var (
+	uuidRe            = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	issueIdentifierRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+	issueURLRe        = regexp.MustCompile(`linear\.app/[^/]+/issue/([A-Za-z][A-Za-z0-9_]*-[0-9]+)`)
+	projectURLRe      = regexp.MustCompile(`linear\.app/[^/]+/project/([^/?#]+)`)
+)
+
+// cacheEntry holds a cached lookup result and when it was fetched
+type cacheEntry[T any] struct {
+	value   T
+	fetched time.Time
+}
+
+// Resolver turns human-friendly references such as team keys, user emails,
+// project names and issue identifiers into Linear IDs. Lookups are cached
+// for a short time so that resolving several references in one tool call
+// does not cost a round trip each.
+type Resolver struct {
+	client *Client
+	ttl    time.Duration
+
+	mu         sync.Mutex // Guards the caches below
+	viewer     cacheEntry[*User]
+	teams      cacheEntry[[]Team]
+	users      cacheEntry[[]User]
+	projects   cacheEntry[[]Project]
+	states     map[string]*cacheEntry[[]WorkflowState]    // Keyed by team ID
+	milestones map[string]*cacheEntry[[]ProjectMilestone] // Keyed by project ID
+	issues     map[string]*cacheEntry[string]             // Issue identifier -> ID
+	issueTeams map[string]*cacheEntry[string]             // Issue ID -> team ID
+	labels     cacheEntry[[]Label]                        // Workspace labels
+	teamLabels map[string]*cacheEntry[[]Label]            // Keyed by team ID
+}
+
+// NewResolver creates a Resolver that caches lookups for ttl. A ttl of 0
+// uses DefaultResolverTTL.
+func NewResolver(client *Client, ttl time.Duration) *Resolver {
+	if ttl <= 0 {
+		ttl = DefaultResolverTTL
+	}
+
+	return &Resolver{
+		client:     client,
+		ttl:        ttl,
+		states:     map[string]*cacheEntry[[]WorkflowState]{},
+		milestones: map[string]*cacheEntry[[]ProjectMilestone]{},
+		issues:     map[string]*cacheEntry[string]{},
+		issueTeams: map[string]*cacheEntry[string]{},
+		teamLabels: map[string]*cacheEntry[[]Label]{},
+	}
+}
+
+// Invalidate drops every cached lookup
+func (r *Resolver) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.viewer = cacheEntry[*User]{}
+	r.teams = cacheEntry[[]Team]{}
+	r.users = cacheEntry[[]User]{}
+	r.projects = cacheEntry[[]Project]{}
+	r.states = map[string]*cacheEntry[[]WorkflowState]{}
+	r.milestones = map[string]*cacheEntry[[]ProjectMilestone]{}
+	r.issues = map[string]*cacheEntry[string]{}
+	r.issueTeams = map[string]*cacheEntry[string]{}
+	r.labels = cacheEntry[[]Label]{}
+	r.teamLabels = map[string]*cacheEntry[[]Label]{}
+}
+
+// loadCached returns the cached value in entry if it is fresh, and otherwise
+// refreshes it with fetch
+func loadCached[T any](r *Resolver, entry *cacheEntry[T], fetch func() (T, error)) (T, error) {
+	r.mu.Lock()
+	if !entry.fetched.IsZero() && time.Since(entry.fetched) < r.ttl {
+		value := entry.value
+		r.mu.Unlock()
+		return value, nil
+	}
+	r.mu.Unlock()
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+
+	r.mu.Lock()
+	entry.value = value
+	entry.fetched = time.Now()
+	r.mu.Unlock()
+
+	return value, nil
+}
+
+// entryFor returns the cache entry for key, creating it if needed
+func entryFor[T any](r *Resolver, entries map[string]*cacheEntry[T], key string) *cacheEntry[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := entries[key]
+	if !ok {
+		entry = &cacheEntry[T]{}
+		entries[key] = entry
+	}
+	return entry
+}
+
+// Teams returns every team in the workspace, from the cache when fresh
+func (r *Resolver) Teams(ctx context.Context) ([]Team, error) {
+	return loadCached(r, &r.teams, func() ([]Team, error) {
+		return r.client.GetTeamsContext(ctx)
+	})
+}
+
+// Viewer returns the authenticated user, from the cache when fresh
+func (r *Resolver) Viewer(ctx context.Context) (*User, error) {
+	return loadCached(r, &r.viewer, func() (*User, error) {
+		return r.client.GetViewerContext(ctx)
+	})
+}
+
+// ResolveTeamID resolves a team ID, key (e.g. "ENG") or name to a team ID.
+// An empty reference resolves to an empty ID.
+func (r *Resolver) ResolveTeamID(ctx context.Context, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	teams, err := r.Teams(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, team := range teams {
+		if team.ID == ref || strings.EqualFold(team.Key, ref) {
+			return team.ID, nil
+		}
+	}
+
+	var matches []Team
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, ref) {
+			matches = append(matches, team)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0].ID, nil
+	case 0:
+		options := make([]string, 0, len(teams))
+		for _, team := range teams {
+			options = append(options, fmt.Sprintf("%s (%s)", team.Key, team.Name))
+		}
+		return "", &NotFoundError{Resource: "team", Ref: ref, Options: options}
+	default:
+		descriptions := make([]string, 0, len(matches))
+		for _, team := range matches {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s)", team.Key, team.Name))
+		}
+		return "", &AmbiguousError{Resource: "team", Ref: ref, Matches: descriptions}
+	}
+}
+
+// ResolveTeamIDs resolves each reference with ResolveTeamID
+func (r *Resolver) ResolveTeamIDs(ctx context.Context, refs []string) ([]string, error) {
+	return r.resolveAll(ctx, refs, r.ResolveTeamID)
+}
+
+// ResolveUserID resolves "me", a user ID, email, display name or full name to
+// a user ID. An empty reference resolves to an empty ID.
+func (r *Resolver) ResolveUserID(ctx context.Context, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	if strings.EqualFold(ref, "me") {
+		viewer, err := r.Viewer(ctx)
+		if err != nil {
+			return "", err
+		}
+		return viewer.ID, nil
+	}
+
+	users, err := loadCached(r, &r.users, func() ([]User, error) {
+		return r.client.GetAllUsersContext(ctx, 0)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	name := strings.TrimPrefix(ref, "@")
+	var matches []User
+	for _, user := range users {
+		if user.ID == ref || strings.EqualFold(user.Email, ref) {
+			return user.ID, nil
+		}
+		if strings.EqualFold(user.DisplayName, name) || strings.EqualFold(user.Name, name) {
+			matches = append(matches, user)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0].ID, nil
+	case 0:
+		return "", &NotFoundError{Resource: "user", Ref: ref}
+	default:
+		descriptions := make([]string, 0, len(matches))
+		for _, user := range matches {
+			descriptions = append(descriptions, fmt.Sprintf("%s <%s>", user.Name, user.Email))
+		}
+		return "", &AmbiguousError{Resource: "user", Ref: ref, Matches: descriptions}
+	}
+}
+
+// ResolveProjectID resolves a project ID, slug ID, URL or name to a project
+// ID. An empty reference resolves to an empty ID.
+func (r *Resolver) ResolveProjectID(ctx context.Context, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	// Project URLs end in a slug such as "my-project-2f1a3b4c5d6e"
+	slug := ref
+	if match := projectURLRe.FindStringSubmatch(ref); match != nil {
+		slug = match[1]
+	}
+
+	projects, err := loadCached(r, &r.projects, func() ([]Project, error) {
+		return r.client.GetAllProjectsContext(ctx, nil, 0)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var matches []Project
+	for _, project := range projects {
+		if project.ID == ref || (project.SlugID != "" && (project.SlugID == slug || strings.HasSuffix(slug, "-"+project.SlugID))) {
+			return project.ID, nil
+		}
+		if strings.EqualFold(project.Name, ref) {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0].ID, nil
+	case 0:
+		return "", &NotFoundError{Resource: "project", Ref: ref}
+	default:
+		descriptions := make([]string, 0, len(matches))
+		for _, project := range matches {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s)", project.Name, project.SlugID))
+		}
+		return "", &AmbiguousError{Resource: "project", Ref: ref, Matches: descriptions}
+	}
+}
+
+// ResolveIssueID resolves an issue ID, identifier (e.g. "ENG-123") or issue
+// URL to an issue ID. An empty reference resolves to an empty ID.
+func (r *Resolver) ResolveIssueID(ctx context.Context, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	identifier := ref
+	if match := issueURLRe.FindStringSubmatch(ref); match != nil {
+		identifier = match[1]
+	}
+
+	if !issueIdentifierRe.MatchString(identifier) {
+		return "", &NotFoundError{Resource: "issue", Ref: ref}
+	}
+	identifier = strings.ToUpper(identifier)
+
+	entry := entryFor(r, r.issues, identifier)
+	return loadCached(r, entry, func() (string, error) {
+		// The issue query accepts identifiers as well as IDs
+		issue, err := r.client.GetIssueContext(ctx, identifier, nil)
+		if err != nil {
+			return "", err
+		}
+		return issue.ID, nil
+	})
+}
+
+// ResolveStateID resolves a workflow state ID, name or type (see
+// ResolveWorkflowState) within a team to a state ID. An empty reference
+// resolves to an empty ID.
+func (r *Resolver) ResolveStateID(ctx context.Context, teamID, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	entry := entryFor(r, r.states, teamID)
+	states, err := loadCached(r, entry, func() ([]WorkflowState, error) {
+		return r.client.GetTeamWorkflowStatesContext(ctx, teamID)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	state, err := matchWorkflowState(states, ref)
+	if err != nil {
+		return "", err
+	}
+	return state.ID, nil
+}
+
+// ResolveIssueStateID resolves a workflow state reference (see
+// ResolveStateID) within the team the issue currently belongs to
+func (r *Resolver) ResolveIssueStateID(ctx context.Context, issueRef, stateRef string) (string, error) {
+	stateRef = strings.TrimSpace(stateRef)
+	if stateRef == "" || uuidRe.MatchString(stateRef) {
+		return stateRef, nil
+	}
+
+	teamID, err := r.issueTeamID(ctx, issueRef)
+	if err != nil {
+		return "", err
+	}
+
+	return r.ResolveStateID(ctx, teamID, stateRef)
+}
+
+// issueTeamID returns the ID of the team an issue currently belongs to. The
+// team is read from the issue rather than its identifier, since an issue
+// that moved teams keeps its old identifier.
+func (r *Resolver) issueTeamID(ctx context.Context, issueRef string) (string, error) {
+	issueID, err := r.ResolveIssueID(ctx, issueRef)
+	if err != nil {
+		return "", err
+	}
+
+	entry := entryFor(r, r.issueTeams, issueID)
+	return loadCached(r, entry, func() (string, error) {
+		issue, err := r.client.GetIssueContext(ctx, issueID, nil)
+		if err != nil {
+			return "", err
+		}
+		if issue.Team == nil {
+			return "", fmt.Errorf("could not determine the team of issue %s", issueRef)
+		}
+		return issue.Team.ID, nil
+	})
+}
+
+// ResolveLabelID resolves a label ID or name to a label ID. Names are
+// matched case-insensitively against the team's labels first and then
+// against workspace labels; the team is optional. An empty reference
+// resolves to an empty ID.
+func (r *Resolver) ResolveLabelID(ctx context.Context, teamID, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	if teamID != "" {
+		entry := entryFor(r, r.teamLabels, teamID)
+		labels, err := loadCached(r, entry, func() ([]Label, error) {
+			return r.client.GetAllTeamLabelsContext(ctx, teamID, 0)
+		})
+		if err != nil {
+			return "", err
+		}
+
+		if id, err := matchLabel(labels, ref); !errors.Is(err, ErrNotFound) {
+			return id, err
+		}
+	}
+
+	labels, err := loadCached(r, &r.labels, func() ([]Label, error) {
+		return r.client.GetAllWorkspaceLabelsContext(ctx, 0)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return matchLabel(labels, ref)
+}
+
+// ResolveLabelIDs resolves each reference with ResolveLabelID
+func (r *Resolver) ResolveLabelIDs(ctx context.Context, teamID string, refs []string) ([]string, error) {
+	return r.resolveAll(ctx, refs, func(ctx context.Context, ref string) (string, error) {
+		return r.ResolveLabelID(ctx, teamID, ref)
+	})
+}
+
+// ResolveIssueLabelIDs resolves each label reference (see ResolveLabelID)
+// within the team of an issue. The issue is only fetched when a reference
+// is a name.
+func (r *Resolver) ResolveIssueLabelIDs(ctx context.Context, issueRef string, refs []string) ([]string, error) {
+	teamID := ""
+	for _, ref := range refs {
+		if ref = strings.TrimSpace(ref); ref == "" || uuidRe.MatchString(ref) {
+			continue
+		}
+
+		var err error
+		if teamID, err = r.issueTeamID(ctx, issueRef); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	return r.ResolveLabelIDs(ctx, teamID, refs)
+}
+
+// matchLabel finds the one label named ref
+func matchLabel(labels []Label, ref string) (string, error) {
+	var matches []Label
+	for _, label := range labels {
+		if strings.EqualFold(label.Name, ref) {
+			matches = append(matches, label)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0].ID, nil
+	case 0:
+		return "", &NotFoundError{Resource: "label", Ref: ref}
+	default:
+		descriptions := make([]string, 0, len(matches))
+		for _, label := range matches {
+			if label.Parent != nil {
+				descriptions = append(descriptions, fmt.Sprintf("%s/%s (%s)", label.Parent.Name, label.Name, label.ID))
+			} else {
+				descriptions = append(descriptions, fmt.Sprintf("%s (%s)", label.Name, label.ID))
+			}
+		}
+		return "", &AmbiguousError{Resource: "label", Ref: ref, Matches: descriptions}
+	}
+}
+
+// ResolveProjectMilestoneID resolves a milestone ID or name within a
+// project to a milestone ID. Names are matched case-insensitively, so a
+// project is required unless ref is already an ID. An empty reference
+// resolves to an empty ID.
+func (r *Resolver) ResolveProjectMilestoneID(ctx context.Context, projectID, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	if projectID == "" {
+		return "", fmt.Errorf("milestone %q can only be looked up by name within a project", ref)
+	}
+
+	entry := entryFor(r, r.milestones, projectID)
+	milestones, err := loadCached(r, entry, func() ([]ProjectMilestone, error) {
+		return r.client.GetAllProjectMilestonesContext(ctx, projectID, 0)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var matches []ProjectMilestone
+	for _, milestone := range milestones {
+		if strings.EqualFold(milestone.Name, ref) {
+			matches = append(matches, milestone)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0].ID, nil
+	case 0:
+		return "", &NotFoundError{Resource: "project milestone", Ref: ref}
+	default:
+		descriptions := make([]string, 0, len(matches))
+		for _, milestone := range matches {
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s)", milestone.Name, milestone.ID))
+		}
+		return "", &AmbiguousError{Resource: "project milestone", Ref: ref, Matches: descriptions}
+	}
+}
+
+// ResolveIssueProjectMilestoneID resolves a milestone reference (see
+// ResolveProjectMilestoneID) within the project an issue belongs to. The
+// issue is only fetched when ref is a name.
+func (r *Resolver) ResolveIssueProjectMilestoneID(ctx context.Context, issueID, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" || uuidRe.MatchString(ref) {
+		return ref, nil
+	}
+
+	issue, err := r.client.GetIssueContext(ctx, issueID, nil)
+	if err != nil {
+		return "", err
+	}
+	if issue.Project == nil {
+		return "", fmt.Errorf("issue %s is not in a project, so milestone %q cannot be looked up by name", issueID, ref)
+	}
+
+	return r.ResolveProjectMilestoneID(ctx, issue.Project.ID, ref)
+}
+
+// ResolveIssueFilter resolves the team, user, project and parent issue
+// references in filter to IDs in place, so that filters built from
+// human-friendly references (e.g. by ParseIssueQuery) can be searched
+func (r *Resolver) ResolveIssueFilter(ctx context.Context, filter *IssueFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	var err error
+	if filter.TeamIDs, err = r.resolveAll(ctx, filter.TeamIDs, r.ResolveTeamID); err != nil {
+		return err
+	}
+
+	if filter.AssigneeIDs, err = r.resolveAll(ctx, filter.AssigneeIDs, r.ResolveUserID); err != nil {
+		return err
+	}
+
+	if filter.CreatorIDs, err = r.resolveAll(ctx, filter.CreatorIDs, r.ResolveUserID); err != nil {
+		return err
+	}
+
+	if filter.SubscriberIDs, err = r.resolveAll(ctx, filter.SubscriberIDs, r.ResolveUserID); err != nil {
+		return err
+	}
+
+	if filter.ProjectIDs, err = r.resolveAll(ctx, filter.ProjectIDs, r.ResolveProjectID); err != nil {
+		return err
+	}
+
+	filter.ParentID, err = r.ResolveIssueID(ctx, filter.ParentID)
+	return err
+}
+
+// resolveAll resolves each reference with resolve
+func (r *Resolver) resolveAll(ctx context.Context, refs []string, resolve func(context.Context, string) (string, error)) ([]string, error) {
+	if refs == nil {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		id, err := resolve(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,288 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestResolver(t *testing.T) {
+	requests := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetTeams"):
+			requests["teams"]++
+			w.Write([]byte(`{"data": {"teams": {"nodes": [
+				{"id": "team1", "name": "Engineering", "key": "ENG"},
+				{"id": "team2", "name": "Design", "key": "DES"}
+			]}}}`))
+		case strings.HasPrefix(req.Query, "query GetViewer"):
+			requests["viewer"]++
+			w.Write([]byte(`{"data": {"viewer": {"id": "user1", "name": "Ada Lovelace", "displayName": "ada", "email": "ada@example.com"}}}`))
+		case strings.HasPrefix(req.Query, "query GetUsers"):
+			requests["users"]++
+			w.Write([]byte(`{"data": {"users": {"nodes": [
+				{"id": "user1", "name": "Ada Lovelace", "displayName": "ada", "email": "ada@example.com"},
+				{"id": "user2", "name": "Sam Smith", "displayName": "sam", "email": "sam@example.com"},
+				{"id": "user3", "name": "Sam Smith", "displayName": "samuel", "email": "samuel@example.com"}
+			], "pageInfo": {"hasNextPage": false}}}}`))
+		case strings.HasPrefix(req.Query, "query GetProjects"):
+			requests["projects"]++
+			w.Write([]byte(`{"data": {"projects": {"nodes": [
+				{"id": "project1", "name": "Launch", "slugId": "a1b2c3d4e5f6"}
+			], "pageInfo": {"hasNextPage": false}}}}`))
+		case strings.HasPrefix(req.Query, "query GetIssue"):
+			requests["issue"]++
+			if req.Variables["id"] != "ENG-123" {
+				w.Write([]byte(`{"data": {"issue": null}}`))
+				return
+			}
+			w.Write([]byte(`{"data": {"issue": {"id": "issue1", "identifier": "ENG-123"}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewResolver(NewClient("test_api_key", WithURL(server.URL)), 0)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		resolve func(context.Context, string) (string, error)
+		ref     string
+		wantID  string
+	}{
+		{name: "team key", resolve: resolver.ResolveTeamID, ref: "eng", wantID: "team1"},
+		{name: "team name", resolve: resolver.ResolveTeamID, ref: "Design", wantID: "team2"},
+		{name: "team ID", resolve: resolver.ResolveTeamID, ref: "team2", wantID: "team2"},
+		{name: "empty team", resolve: resolver.ResolveTeamID, ref: "", wantID: ""},
+		{name: "me", resolve: resolver.ResolveUserID, ref: "me", wantID: "user1"},
+		{name: "user email", resolve: resolver.ResolveUserID, ref: "SAM@example.com", wantID: "user2"},
+		{name: "user display name", resolve: resolver.ResolveUserID, ref: "@samuel", wantID: "user3"},
+		{name: "project name", resolve: resolver.ResolveProjectID, ref: "launch", wantID: "project1"},
+		{name: "project slug", resolve: resolver.ResolveProjectID, ref: "launch-a1b2c3d4e5f6", wantID: "project1"},
+		{name: "project URL", resolve: resolver.ResolveProjectID, ref: "https://linear.app/acme/project/launch-a1b2c3d4e5f6/overview", wantID: "project1"},
+		{name: "issue identifier", resolve: resolver.ResolveIssueID, ref: "eng-123", wantID: "issue1"},
+		{name: "issue URL", resolve: resolver.ResolveIssueID, ref: "https://linear.app/acme/issue/ENG-123/fix-login", wantID: "issue1"},
+		{name: "issue UUID", resolve: resolver.ResolveIssueID, ref: "8f1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8", wantID: "8f1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := tt.resolve(ctx, tt.ref)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if id != tt.wantID {
+				t.Errorf("Expected %q to resolve to %q, got %q", tt.ref, tt.wantID, id)
+			}
+		})
+	}
+
+	// Each collection is fetched once and then served from the cache
+	for resource, count := range requests {
+		if count != 1 {
+			t.Errorf("Expected 1 %s request, got %d", resource, count)
+		}
+	}
+
+	if _, err := resolver.ResolveUserID(ctx, "Sam Smith"); !errors.Is(err, ErrAmbiguous) {
+		t.Errorf("Expected an ambiguous user error, got %v", err)
+	}
+
+	_, err := resolver.ResolveTeamID(ctx, "OPS")
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Expected a NotFoundError, got %v", err)
+	}
+
+	if len(notFound.Options) != 2 {
+		t.Errorf("Expected the error to list 2 teams, got %v", notFound.Options)
+	}
+
+	if _, err := resolver.ResolveIssueID(ctx, "ENG-999"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a not found issue error, got %v", err)
+	}
+
+	// Invalidate forces the next lookup to refetch
+	resolver.Invalidate()
+	if _, err := resolver.ResolveTeamID(ctx, "ENG"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if requests["teams"] != 2 {
+		t.Errorf("Expected teams to be refetched after Invalidate, got %d requests", requests["teams"])
+	}
+}
+
+func TestUpdateIssueUnassigns(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		if !strings.HasPrefix(req.Query, "mutation UpdateIssue") {
+			t.Errorf("Unexpected query: %s", req.Query)
+			return
+		}
+
+		input, _ := json.Marshal(req.Variables["input"])
+		if string(input) != `{"assigneeId":null}` {
+			t.Errorf("Expected the assignee to be cleared with null, got %s", input)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": {"issueUpdate": {"success": true, "issue": {"id": "issue1", "identifier": "ENG-123"}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	// An empty assignee resolves to an empty ID, which must reach Linear as null
+	assigneeID, err := NewResolver(client, 0).ResolveUserID(context.Background(), "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := client.UpdateIssue("issue1", UpdateIssueInput{AssigneeID: &assigneeID}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestResolveIssueStateIDUsesIssueTeam(t *testing.T) {
+	issueFetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetIssue"):
+			issueFetches++
+			// ENG-12 moved to the OPS team and became OPS-5
+			w.Write([]byte(`{"data": {"issue": {"id": "issue1", "identifier": "OPS-5", "team": {"id": "team-ops", "key": "OPS"}}}}`))
+		case strings.HasPrefix(req.Query, "query GetTeamWorkflowStates"):
+			if req.Variables["teamId"] != "team-ops" {
+				t.Errorf("Expected states of the issue's current team, got %v", req.Variables["teamId"])
+			}
+			w.Write([]byte(`{"data": {"team": {"states": {"nodes": [
+				{"id": "ops-done", "name": "Done", "type": "completed"}
+			]}}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewResolver(NewClient("test_api_key", WithURL(server.URL)), 0)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if id, err := resolver.ResolveIssueStateID(ctx, "ENG-12", "done"); err != nil || id != "ops-done" {
+			t.Errorf("Expected Done to resolve in the OPS team, got %q, %v", id, err)
+		}
+	}
+
+	// One fetch resolves the identifier and one finds the team, both cached
+	if issueFetches != 2 {
+		t.Errorf("Expected the issue to be fetched twice in total, got %d", issueFetches)
+	}
+}
+
+func TestResolveLabelID(t *testing.T) {
+	requests := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetTeamLabels"):
+			requests["team"]++
+			w.Write([]byte(`{"data": {"team": {"labels": {"nodes": [
+				{"id": "eng-bug", "name": "Bug"},
+				{"id": "eng-slow", "name": "Slow", "parent": {"id": "g1", "name": "Performance"}},
+				{"id": "eng-slow2", "name": "slow", "parent": {"id": "g2", "name": "UX"}}
+			], "pageInfo": {"hasNextPage": false}}}}}`))
+		case strings.HasPrefix(req.Query, "query GetWorkspaceLabels"):
+			requests["workspace"]++
+			w.Write([]byte(`{"data": {"issueLabels": {"nodes": [
+				{"id": "ws-bug", "name": "Bug"},
+				{"id": "ws-design", "name": "needs-design"}
+			], "pageInfo": {"hasNextPage": false}}}}`))
+		case strings.HasPrefix(req.Query, "query GetIssue"):
+			requests["issue"]++
+			w.Write([]byte(`{"data": {"issue": {"id": "issue1", "identifier": "ENG-1", "team": {"id": "team1", "key": "ENG"}}}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	resolver := NewResolver(NewClient("test_api_key", WithURL(server.URL)), 0)
+	ctx := context.Background()
+
+	tests := []struct {
+		teamID, ref, want string
+	}{
+		{"team1", "bug", "eng-bug"},            // Team labels win over workspace labels
+		{"team1", "Needs-Design", "ws-design"}, // Falls back to workspace labels
+		{"", "bug", "ws-bug"},                  // Workspace labels only without a team
+		{"team1", "", ""},
+		{"", "9f3c2a1e-1111-2222-3333-444455556666", "9f3c2a1e-1111-2222-3333-444455556666"},
+	}
+	for _, tt := range tests {
+		if id, err := resolver.ResolveLabelID(ctx, tt.teamID, tt.ref); err != nil || id != tt.want {
+			t.Errorf("ResolveLabelID(%q, %q) = %q, %v; want %q", tt.teamID, tt.ref, id, err, tt.want)
+		}
+	}
+
+	if _, err := resolver.ResolveLabelID(ctx, "team1", "slow"); !errors.Is(err, ErrAmbiguous) || !strings.Contains(err.Error(), "Performance/Slow") {
+		t.Errorf("Expected two labels named slow to be ambiguous, got %v", err)
+	}
+	if _, err := resolver.ResolveLabelID(ctx, "team1", "wontfix"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected an unknown label to be not found, got %v", err)
+	}
+
+	if requests["team"] != 1 || requests["workspace"] != 1 {
+		t.Errorf("Expected labels to be fetched once and cached, got %v", requests)
+	}
+
+	// IDs don't need the issue's team, names do
+	if _, err := resolver.ResolveIssueLabelIDs(ctx, "ENG-1", []string{"9f3c2a1e-1111-2222-3333-444455556666"}); err != nil || requests["issue"] != 0 {
+		t.Errorf("Expected label IDs to resolve without fetching the issue, got %v after %d fetches", err, requests["issue"])
+	}
+	if ids, err := resolver.ResolveIssueLabelIDs(ctx, "ENG-1", []string{"bug", "needs-design"}); err != nil || len(ids) != 2 || ids[0] != "eng-bug" || ids[1] != "ws-design" {
+		t.Errorf("Expected names to resolve within the issue's team, got %v, %v", ids, err)
+	}
+}
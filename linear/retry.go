@@ -0,0 +1,204 @@
+package linear
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures how the client retries failed requests and how it
+// paces itself against Linear's rate limits
+type RetryPolicy struct {
+	MaxRetries     int           // Maximum number of retries after the first attempt (0 disables retries)
+	BaseDelay      time.Duration // Backoff before the first retry; doubles on every further attempt
+	MaxDelay       time.Duration // Upper bound on any single pause, including rate-limit waits
+	RetryMutations bool          // Whether mutations may be retried; they are not idempotent, so this is off by default
+
+	MinRequestsRemaining   int // Pause until the window resets once this few requests remain
+	MinComplexityRemaining int // Pause until the window resets once this little complexity remains
+}
+
+// DefaultRetryPolicy returns the retry policy used by clients created with NewClient
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:             3,
+		BaseDelay:              500 * time.Millisecond,
+		MaxDelay:               30 * time.Second,
+		MinRequestsRemaining:   5,
+		MinComplexityRemaining: 10000,
+	}
+}
+
+// NoRetryPolicy returns a policy that never retries or pauses
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{}
+}
+
+// WithRetryPolicy sets the retry policy for the client
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// backoff returns the jittered delay to wait before the given retry attempt (0-based)
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := p.BaseDelay << attempt
+	if delay <= 0 || (p.MaxDelay > 0 && delay > p.MaxDelay) {
+		delay = p.MaxDelay
+	}
+
+	// Equal jitter: wait at least half the delay so retries still back off
+	half := delay / 2
+	return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// capDelay limits a server-requested delay to the policy's MaxDelay
+func (p RetryPolicy) capDelay(d time.Duration) time.Duration {
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
+
+// RateLimitStatus is the most recent rate-limit state reported by Linear.
+// Zero reset times mean the corresponding header has not been seen yet.
+type RateLimitStatus struct {
+	RequestsLimit       int       `json:"requestsLimit"`
+	RequestsRemaining   int       `json:"requestsRemaining"`
+	RequestsReset       time.Time `json:"requestsReset"`
+	ComplexityLimit     int       `json:"complexityLimit"`
+	ComplexityRemaining int       `json:"complexityRemaining"`
+	ComplexityReset     time.Time `json:"complexityReset"`
+	LastComplexity      int       `json:"lastComplexity"` // Complexity of the most recent query
+}
+
+// RateLimit returns the most recent rate-limit state reported by Linear
+func (c *Client) RateLimit() RateLimitStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimit
+}
+
+// updateRateLimit records the rate-limit headers from a Linear response
+func (c *Client) updateRateLimit(header http.Header) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if v, ok := headerInt(header, "X-RateLimit-Requests-Limit"); ok {
+		c.rateLimit.RequestsLimit = v
+	}
+	if v, ok := headerInt(header, "X-RateLimit-Requests-Remaining"); ok {
+		c.rateLimit.RequestsRemaining = v
+	}
+	if v, ok := headerInt(header, "X-RateLimit-Requests-Reset"); ok {
+		c.rateLimit.RequestsReset = time.UnixMilli(int64(v))
+	}
+	if v, ok := headerInt(header, "X-RateLimit-Complexity-Limit"); ok {
+		c.rateLimit.ComplexityLimit = v
+	}
+	if v, ok := headerInt(header, "X-RateLimit-Complexity-Remaining"); ok {
+		c.rateLimit.ComplexityRemaining = v
+	}
+	if v, ok := headerInt(header, "X-RateLimit-Complexity-Reset"); ok {
+		c.rateLimit.ComplexityReset = time.UnixMilli(int64(v))
+	}
+	if v, ok := headerInt(header, "X-Complexity"); ok {
+		c.rateLimit.LastComplexity = v
+	}
+}
+
+// rateLimitPause returns how long to wait before sending another request so
+// that the remaining request and complexity budgets are not exhausted
+func (c *Client) rateLimitPause(now time.Time) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var pause time.Duration
+
+	rl := c.rateLimit
+	if !rl.RequestsReset.IsZero() && rl.RequestsRemaining <= c.retry.MinRequestsRemaining {
+		pause = max(pause, rl.RequestsReset.Sub(now))
+	}
+	if !rl.ComplexityReset.IsZero() && rl.ComplexityRemaining <= c.retry.MinComplexityRemaining {
+		pause = max(pause, rl.ComplexityReset.Sub(now))
+	}
+
+	return pause
+}
+
+// waitForRateLimit pauses proactively when the rate-limit budget is nearly
+// exhausted. Pauses longer than MaxDelay are skipped and left to the API to reject.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.retry.MaxDelay <= 0 {
+		return nil
+	}
+
+	pause := c.rateLimitPause(time.Now())
+	if pause <= 0 || pause > c.retry.MaxDelay {
+		return nil
+	}
+
+	return sleep(ctx, pause)
+}
+
+// retryAfter returns the delay requested by a rate-limited response, if any
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	if v, ok := headerInt(header, "Retry-After"); ok {
+		return time.Duration(v) * time.Second
+	}
+
+	var delay time.Duration
+	for _, name := range []string{"X-RateLimit-Requests-Reset", "X-RateLimit-Complexity-Reset"} {
+		if v, ok := headerInt(header, name); ok {
+			delay = max(delay, time.UnixMilli(int64(v)).Sub(now))
+		}
+	}
+	return delay
+}
+
+// isMutation reports whether a GraphQL document is a mutation
+func isMutation(query string) bool {
+	for _, line := range strings.Split(query, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		return strings.HasPrefix(line, "mutation")
+	}
+	return false
+}
+
+// headerInt parses an integer header value
+func headerInt(header http.Header, name string) (int, bool) {
+	raw := header.Get(name)
+	if raw == "" {
+		return 0, false
+	}
+
+	v, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
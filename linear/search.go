@@ -0,0 +1,248 @@
+package linear
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// Issue orderings accepted by SearchIssuesOptions.OrderBy
+const (
+	OrderByCreatedAt = "createdAt"
+	OrderByUpdatedAt = "updatedAt"
+)
+
+// IssueFilter describes which issues to return from SearchIssues. Every set
+// field must match; fields listing several values match any one of them,
+// except Labels, where an issue must carry every listed label.
+type IssueFilter struct {
+	TeamIDs       []string // Teams the issue belongs to
+	StateTypes    []string // Workflow state types, e.g. "started" (see StateType*)
+	StateNames    []string // Workflow state names, e.g. "In Review"
+	AssigneeIDs   []string // Users the issue is assigned to
+	Unassigned    bool     // Only issues without an assignee
+	CreatorIDs    []string // Users who created the issue
+	SubscriberIDs []string // Users subscribed to the issue
+	Labels        []string // Label IDs or names the issue must all carry
+	Priorities    []int    // Priorities, from 0 (none) and 1 (urgent) to 4 (low)
+	ProjectIDs    []string // Projects the issue belongs to
+	Cycle         string   // Cycle ID, number, or "current"/"next"/"previous"
+	ParentID      string   // Parent issue the issue is a sub-issue of
+	HasParent     *bool    // Only sub-issues when true, or only top-level issues when false
+
+	// Date bounds accept ISO 8601 dates (e.g. "2024-05-01") or durations
+	// relative to now (e.g. "-P2W" for two weeks ago)
+	CreatedAfter  string
+	CreatedBefore string
+	UpdatedAfter  string
+	UpdatedBefore string
+	DueAfter      string
+	DueBefore     string
+}
+
+// SearchIssuesOptions contains the parameters for searching issues
+type SearchIssuesOptions struct {
+	Term            string       // Optional full-text search term matched against titles, descriptions and comments
+	Filter          *IssueFilter // Optional structured filter
+	OrderBy         string       // OrderByCreatedAt or OrderByUpdatedAt; Linear's default when empty
+	IncludeArchived bool         // Whether to include archived issues
+	First           int          // Number of issues to fetch (max 100)
+	After           string       // Cursor to start fetching after
+}
+
+// SearchIssues returns a page of issues matching a full-text term and/or a
+// structured filter
+func (c *Client) SearchIssues(opts *SearchIssuesOptions) (*Page[Issue], error) {
+	return c.SearchIssuesContext(context.Background(), opts)
+}
+
+// SearchIssuesContext is like SearchIssues but honors ctx for cancellation and deadlines
+func (c *Client) SearchIssuesContext(ctx context.Context, opts *SearchIssuesOptions) (*Page[Issue], error) {
+	if opts == nil {
+		opts = &SearchIssuesOptions{}
+	}
+
+	variables := map[string]interface{}{}
+	paginationVariables(variables, opts.First, opts.After)
+
+	if filter := opts.Filter.toGraphQL(); len(filter) > 0 {
+		variables["filter"] = filter
+	}
+
+	if opts.OrderBy != "" {
+		variables["orderBy"] = opts.OrderBy
+	}
+
+	if opts.IncludeArchived {
+		variables["includeArchived"] = true
+	}
+
+	// Full-text search has its own root field; plain filtering goes through issues
+	if term := strings.TrimSpace(opts.Term); term != "" {
+		variables["term"] = term
+
+		data, err := queryInto[struct {
+			SearchIssues Page[Issue] `json:"searchIssues"`
+		}](ctx, c, "search_issues.graphql", variables)
+		if err != nil {
+			return nil, err
+		}
+
+		return &data.SearchIssues, nil
+	}
+
+	data, err := queryInto[struct {
+		Issues Page[Issue] `json:"issues"`
+	}](ctx, c, "filter_issues.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.Issues, nil
+}
+
+// SearchAllIssues returns up to limit issues matching opts, following page
+// cursors as needed. A limit of 0 or less returns every matching issue.
+func (c *Client) SearchAllIssues(opts *SearchIssuesOptions, limit int) ([]Issue, error) {
+	return c.SearchAllIssuesContext(context.Background(), opts, limit)
+}
+
+// SearchAllIssuesContext is like SearchAllIssues but honors ctx for cancellation and deadlines
+func (c *Client) SearchAllIssuesContext(ctx context.Context, opts *SearchIssuesOptions, limit int) ([]Issue, error) {
+	pageOpts := SearchIssuesOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	pageOpts.First = maxPageSize
+
+	return CollectPages(limit, func(after string) (*Page[Issue], error) {
+		pageOpts.After = after
+		return c.SearchIssuesContext(ctx, &pageOpts)
+	})
+}
+
+// toGraphQL compiles the filter into Linear's IssueFilter input object
+func (f *IssueFilter) toGraphQL() map[string]interface{} {
+	filter := map[string]interface{}{}
+	if f == nil {
+		return filter
+	}
+
+	if len(f.TeamIDs) > 0 {
+		filter["team"] = idIn(f.TeamIDs)
+	}
+
+	state := map[string]interface{}{}
+	if len(f.StateTypes) > 0 {
+		state["type"] = map[string]interface{}{"in": f.StateTypes}
+	}
+	if len(f.StateNames) > 0 {
+		state["name"] = map[string]interface{}{"in": f.StateNames}
+	}
+	if len(state) > 0 {
+		filter["state"] = state
+	}
+
+	if f.Unassigned {
+		filter["assignee"] = map[string]interface{}{"null": true}
+	} else if len(f.AssigneeIDs) > 0 {
+		filter["assignee"] = idIn(f.AssigneeIDs)
+	}
+
+	if len(f.CreatorIDs) > 0 {
+		filter["creator"] = idIn(f.CreatorIDs)
+	}
+
+	if len(f.SubscriberIDs) > 0 {
+		filter["subscribers"] = map[string]interface{}{"some": idIn(f.SubscriberIDs)}
+	}
+
+	if len(f.Labels) > 0 {
+		labels := make([]interface{}, 0, len(f.Labels))
+		for _, label := range f.Labels {
+			labels = append(labels, map[string]interface{}{
+				"labels": map[string]interface{}{"some": labelMatch(label)},
+			})
+		}
+		filter["and"] = labels
+	}
+
+	if len(f.Priorities) > 0 {
+		filter["priority"] = map[string]interface{}{"in": f.Priorities}
+	}
+
+	if len(f.ProjectIDs) > 0 {
+		filter["project"] = idIn(f.ProjectIDs)
+	}
+
+	if f.Cycle != "" {
+		filter["cycle"] = cycleMatch(f.Cycle)
+	}
+
+	if f.ParentID != "" {
+		filter["parent"] = map[string]interface{}{"id": map[string]interface{}{"eq": f.ParentID}}
+	} else if f.HasParent != nil {
+		filter["parent"] = map[string]interface{}{"null": !*f.HasParent}
+	}
+
+	if dates := dateRange(f.CreatedAfter, f.CreatedBefore); dates != nil {
+		filter["createdAt"] = dates
+	}
+
+	if dates := dateRange(f.UpdatedAfter, f.UpdatedBefore); dates != nil {
+		filter["updatedAt"] = dates
+	}
+
+	if dates := dateRange(f.DueAfter, f.DueBefore); dates != nil {
+		filter["dueDate"] = dates
+	}
+
+	return filter
+}
+
+// idIn matches an entity whose ID is one of ids
+func idIn(ids []string) map[string]interface{} {
+	return map[string]interface{}{"id": map[string]interface{}{"in": ids}}
+}
+
+// labelMatch matches a label by ID, or by case-insensitive name otherwise
+func labelMatch(ref string) map[string]interface{} {
+	if uuidRe.MatchString(ref) {
+		return map[string]interface{}{"id": map[string]interface{}{"eq": ref}}
+	}
+	return map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": ref}}
+}
+
+// cycleMatch matches a cycle by ID, number or relative reference
+func cycleMatch(ref string) map[string]interface{} {
+	normalized := strings.ToLower(strings.TrimSpace(ref))
+	switch normalized {
+	case CycleCurrent, "active":
+		return map[string]interface{}{"isActive": map[string]interface{}{"eq": true}}
+	case CycleNext:
+		return map[string]interface{}{"isNext": map[string]interface{}{"eq": true}}
+	case CyclePrevious:
+		return map[string]interface{}{"isPrevious": map[string]interface{}{"eq": true}}
+	}
+
+	if number, err := strconv.Atoi(strings.TrimPrefix(normalized, "#")); err == nil {
+		return map[string]interface{}{"number": map[string]interface{}{"eq": number}}
+	}
+	return map[string]interface{}{"id": map[string]interface{}{"eq": ref}}
+}
+
+// dateRange builds a date comparator from optional inclusive bounds
+func dateRange(after, before string) map[string]interface{} {
+	if after == "" && before == "" {
+		return nil
+	}
+
+	dates := map[string]interface{}{}
+	if after != "" {
+		dates["gte"] = after
+	}
+	if before != "" {
+		dates["lte"] = before
+	}
+	return dates
+}
@@ -0,0 +1,102 @@
+package linear
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIssueFilterToGraphQL(t *testing.T) {
+	hasParent := false
+	filter := &IssueFilter{
+		TeamIDs:      []string{"team1"},
+		StateTypes:   []string{StateTypeStarted},
+		Unassigned:   true,
+		Labels:       []string{"Bug", "8f1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8"},
+		Priorities:   []int{1, 2},
+		Cycle:        "current",
+		HasParent:    &hasParent,
+		CreatedAfter: "-P2W",
+		DueBefore:    "2024-06-30",
+	}
+
+	got, err := json.Marshal(filter.toGraphQL())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `{` +
+		`"and":[{"labels":{"some":{"name":{"eqIgnoreCase":"Bug"}}}},{"labels":{"some":{"id":{"eq":"8f1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8"}}}}],` +
+		`"assignee":{"null":true},` +
+		`"createdAt":{"gte":"-P2W"},` +
+		`"cycle":{"isActive":{"eq":true}},` +
+		`"dueDate":{"lte":"2024-06-30"},` +
+		`"parent":{"null":true},` +
+		`"priority":{"in":[1,2]},` +
+		`"state":{"type":{"in":["started"]}},` +
+		`"team":{"id":{"in":["team1"]}}` +
+		`}`
+	if string(got) != want {
+		t.Errorf("Unexpected filter:\n got: %s\nwant: %s", got, want)
+	}
+
+	var empty *IssueFilter
+	if len(empty.toGraphQL()) != 0 {
+		t.Errorf("Expected a nil filter to compile to an empty object")
+	}
+}
+
+func TestSearchIssuesChoosesQuery(t *testing.T) {
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+		queries = append(queries, strings.SplitN(req.Query, "(", 2)[0])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if req.Variables["term"] != nil {
+			w.Write([]byte(`{"data": {"searchIssues": {"nodes": [{"id": "issue1"}], "pageInfo": {"hasNextPage": false}}}}`))
+			return
+		}
+
+		if req.Variables["orderBy"] != OrderByUpdatedAt {
+			t.Errorf("Expected orderBy updatedAt, got %v", req.Variables["orderBy"])
+		}
+
+		w.Write([]byte(`{"data": {"issues": {"nodes": [{"id": "issue2"}], "pageInfo": {"hasNextPage": false}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	page, err := client.SearchIssues(&SearchIssuesOptions{Term: "login bug"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(page.Nodes) != 1 || page.Nodes[0].ID != "issue1" {
+		t.Errorf("Expected issue1 from full-text search, got %+v", page.Nodes)
+	}
+
+	page, err = client.SearchIssues(&SearchIssuesOptions{
+		Filter:  &IssueFilter{StateTypes: []string{StateTypeStarted}},
+		OrderBy: OrderByUpdatedAt,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(page.Nodes) != 1 || page.Nodes[0].ID != "issue2" {
+		t.Errorf("Expected issue2 from filtered listing, got %+v", page.Nodes)
+	}
+
+	if strings.Join(queries, ",") != "query SearchIssues,query FilterIssues" {
+		t.Errorf("Unexpected queries: %v", queries)
+	}
+}
@@ -1,7 +1,7 @@
 package linear
 
 import (
-	"fmt"
+	"context"
 )
 
 // Team represents a Linear team
@@ -19,120 +19,76 @@ type ProjectStatus struct {
 
 // TeamProject represents a project within a team in Linear
 type TeamProject struct {
-	ID     string        `json:"id"`
-	Name   string        `json:"name"`
-	SlugID string        `json:"slugId"`
+	ID     string         `json:"id"`
+	Name   string         `json:"name"`
+	SlugID string         `json:"slugId"`
 	Status *ProjectStatus `json:"status,omitempty"`
 }
 
 // GetTeamProjectsOptions contains optional parameters for listing team projects
 type GetTeamProjectsOptions struct {
-	First int // Number of projects to fetch (max 100)
+	First int    // Number of projects to fetch (max 100)
+	After string // Cursor to start fetching after
 }
 
 // GetTeams returns all teams in the Linear workspace
 func (c *Client) GetTeams() ([]Team, error) {
-	query := `query {
-		teams {
-			nodes {
-				id
-				name
-				key
-			}
-		}
-	}`
-
-	resp, err := c.ExecuteGraphQL(query, nil)
+	return c.GetTeamsContext(context.Background())
+}
+
+// GetTeamsContext is like GetTeams but honors ctx for cancellation and deadlines
+func (c *Client) GetTeamsContext(ctx context.Context) ([]Team, error) {
+	data, err := queryInto[struct {
+		Teams Page[Team] `json:"teams"`
+	}](ctx, c, "get_teams.graphql", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Extract the teams data
-	teamsData, ok := resp.Data["teams"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid teams data format")
-	}
-
-	nodesData, ok := teamsData["nodes"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid teams nodes format")
-	}
-
-	teams := make([]Team, 0, len(nodesData))
-	for _, node := range nodesData {
-		nodeMap, ok := node.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("invalid team node format")
-		}
-
-		team := Team{
-			ID:   nodeMap["id"].(string),
-			Name: nodeMap["name"].(string),
-			Key:  nodeMap["key"].(string),
-		}
-		teams = append(teams, team)
-	}
-
-	return teams, nil
+	return data.Teams.Nodes, nil
 }
 
-// GetTeamProjects returns all projects for a specific team
-func (c *Client) GetTeamProjects(teamID string, opts *GetTeamProjectsOptions) ([]TeamProject, error) {
-	query, err := getGraphQLQuery("get_team_projects.graphql")
-	if err != nil {
-		return nil, fmt.Errorf("failed to load GraphQL query: %w", err)
-	}
+// GetTeamProjects returns a page of projects for a specific team
+func (c *Client) GetTeamProjects(teamID string, opts *GetTeamProjectsOptions) (*Page[TeamProject], error) {
+	return c.GetTeamProjectsContext(context.Background(), teamID, opts)
+}
 
+// GetTeamProjectsContext is like GetTeamProjects but honors ctx for cancellation and deadlines
+func (c *Client) GetTeamProjectsContext(ctx context.Context, teamID string, opts *GetTeamProjectsOptions) (*Page[TeamProject], error) {
 	variables := map[string]interface{}{
 		"teamId": teamID,
 	}
 
-	resp, err := c.ExecuteGraphQL(query, variables)
-	if err != nil {
-		return nil, err
+	if opts == nil {
+		opts = &GetTeamProjectsOptions{}
 	}
+	paginationVariables(variables, opts.First, opts.After)
 
-	// Extract the team data
-	teamData, ok := resp.Data["team"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid team data format")
+	data, err := queryInto[struct {
+		Team *struct {
+			Projects Page[TeamProject] `json:"projects"`
+		} `json:"team"`
+	}](ctx, c, "get_team_projects.graphql", variables)
+	if err != nil {
+		return nil, err
 	}
 
-	// Extract the projects data
-	projectsData, ok := teamData["projects"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid projects data format")
+	if data.Team == nil {
+		return nil, &NotFoundError{Resource: "team", Ref: teamID}
 	}
 
-	// Extract the nodes data
-	nodesData, ok := projectsData["nodes"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid projects nodes format")
-	}
+	return &data.Team.Projects, nil
+}
 
-	projects := make([]TeamProject, 0, len(nodesData))
-	for _, node := range nodesData {
-		nodeMap, ok := node.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("invalid project node format")
-		}
-
-		project := TeamProject{
-			ID:     safeGetString(nodeMap, "id"),
-			Name:   safeGetString(nodeMap, "name"),
-			SlugID: safeGetString(nodeMap, "slugId"),
-		}
-
-		// Extract status if present
-		if statusMap, ok := nodeMap["status"].(map[string]interface{}); ok {
-			project.Status = &ProjectStatus{
-				ID:   safeGetString(statusMap, "id"),
-				Name: safeGetString(statusMap, "name"),
-			}
-		}
-
-		projects = append(projects, project)
-	}
+// GetAllTeamProjects returns up to limit projects for a team, following page
+// cursors as needed. A limit of 0 or less returns every project in the team.
+func (c *Client) GetAllTeamProjects(teamID string, limit int) ([]TeamProject, error) {
+	return c.GetAllTeamProjectsContext(context.Background(), teamID, limit)
+}
 
-	return projects, nil
+// GetAllTeamProjectsContext is like GetAllTeamProjects but honors ctx for cancellation and deadlines
+func (c *Client) GetAllTeamProjectsContext(ctx context.Context, teamID string, limit int) ([]TeamProject, error) {
+	return CollectPages(limit, func(after string) (*Page[TeamProject], error) {
+		return c.GetTeamProjectsContext(ctx, teamID, &GetTeamProjectsOptions{First: maxPageSize, After: after})
+	})
 }
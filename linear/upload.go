@@ -0,0 +1,95 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// UploadedFile is a file stored by Linear
+type UploadedFile struct {
+	AssetURL    string `json:"assetUrl"` // Permanent URL of the file, for use in markdown and attachments
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+}
+
+// Markdown returns a markdown reference to the file, embedding images and
+// linking anything else
+func (f *UploadedFile) Markdown() string {
+	link := fmt.Sprintf("[%s](%s)", f.Filename, f.AssetURL)
+	if strings.HasPrefix(f.ContentType, "image/") {
+		return "!" + link
+	}
+	return link
+}
+
+// UploadFile uploads size bytes read from body to Linear's file storage. The
+// returned asset URL can be embedded in issue descriptions and comments, or
+// attached to an issue with CreateAttachment.
+func (c *Client) UploadFile(filename, contentType string, size int64, body io.Reader) (*UploadedFile, error) {
+	return c.UploadFileContext(context.Background(), filename, contentType, size, body)
+}
+
+// UploadFileContext is like UploadFile but honors ctx for cancellation and deadlines
+func (c *Client) UploadFileContext(ctx context.Context, filename, contentType string, size int64, body io.Reader) (*UploadedFile, error) {
+	variables := map[string]interface{}{
+		"filename":    filename,
+		"contentType": contentType,
+		"size":        size,
+	}
+
+	// Linear hands out a signed URL to upload the file to
+	data, err := queryInto[struct {
+		FileUpload struct {
+			Success    bool `json:"success"`
+			UploadFile *struct {
+				UploadURL string `json:"uploadUrl"`
+				AssetURL  string `json:"assetUrl"`
+				Headers   []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"headers"`
+			} `json:"uploadFile"`
+		} `json:"fileUpload"`
+	}](ctx, c, "file_upload.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	upload := data.FileUpload.UploadFile
+	if !data.FileUpload.Success || upload == nil {
+		return nil, fmt.Errorf("file upload was not successful")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.UploadURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload request: %w", err)
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", "public, max-age=31536000")
+	for _, header := range upload.Headers {
+		req.Header.Set(header.Key, header.Value)
+	}
+
+	resp, err := c.httpCli.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("failed to upload file: %w", &HTTPError{StatusCode: resp.StatusCode, Status: resp.Status})
+	}
+
+	return &UploadedFile{
+		AssetURL:    upload.AssetURL,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package linear
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUploadFile(t *testing.T) {
+	var uploaded string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if r.URL.Path == "/rejected" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			if r.Header.Get("Content-Type") != "text/plain" || r.Header.Get("X-Goog-Content-Length-Range") != "0,1024" {
+				t.Errorf("Expected content type and signed headers on the upload, got %v", r.Header)
+			}
+
+			body, _ := io.ReadAll(r.Body)
+			uploaded = string(body)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		if !strings.HasPrefix(req.Query, "mutation FileUpload") {
+			t.Errorf("Unexpected query: %s", req.Query)
+			return
+		}
+
+		if req.Variables["size"] != float64(11) || req.Variables["contentType"] != "text/plain" {
+			t.Errorf("Expected content type and size in the request, got %v", req.Variables)
+		}
+
+		path := "/upload"
+		if req.Variables["filename"] == "rejected.log" {
+			path = "/rejected"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+			"fileUpload": map[string]interface{}{
+				"success": true,
+				"uploadFile": map[string]interface{}{
+					"uploadUrl": server.URL + path,
+					"assetUrl":  "https://uploads.linear.app/asset/build.log",
+					"headers":   []map[string]string{{"key": "X-Goog-Content-Length-Range", "value": "0,1024"}},
+				},
+			},
+		}})
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	file, err := client.UploadFile("build.log", "text/plain", 11, strings.NewReader("hello world"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if uploaded != "hello world" {
+		t.Errorf("Expected file contents to be uploaded, got %q", uploaded)
+	}
+
+	if want := "[build.log](https://uploads.linear.app/asset/build.log)"; file.Markdown() != want {
+		t.Errorf("Expected markdown %q, got %q", want, file.Markdown())
+	}
+
+	_, err = client.UploadFile("rejected.log", "text/plain", 11, strings.NewReader("hello world"))
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Expected a rejected upload to be forbidden, got %v", err)
+	}
+}
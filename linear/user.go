@@ -1,42 +1,177 @@
 package linear
 
 import (
-	"fmt"
+	"context"
 )
 
-// User represents a Linear user
+// User represents a Linear user. The account details below Active are only
+// loaded by user directory queries such as GetUsers, GetUser and GetViewer.
 type User struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Email       string `json:"email"`
+	Active      bool   `json:"active"`          // Whether the account is enabled
+	Guest       bool   `json:"guest,omitempty"` // Whether the user is a guest with access to selected teams only
+	Admin       bool   `json:"admin,omitempty"` // Whether the user is a workspace admin
+	AvatarURL   string `json:"avatarUrl,omitempty"`
+	Timezone    string `json:"timezone,omitempty"` // IANA time zone, e.g. Europe/Berlin
 }
 
 // GetViewer returns information about the authenticated user
 func (c *Client) GetViewer() (*User, error) {
-	query := `query {
-		viewer {
-			id
-			name
-			email
+	return c.GetViewerContext(context.Background())
+}
+
+// GetViewerContext is like GetViewer but honors ctx for cancellation and deadlines
+func (c *Client) GetViewerContext(ctx context.Context) (*User, error) {
+	data, err := queryInto[struct {
+		Viewer User `json:"viewer"`
+	}](ctx, c, "get_viewer.graphql", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.Viewer, nil
+}
+
+// GetUser returns a user by ID
+func (c *Client) GetUser(userID string) (*User, error) {
+	return c.GetUserContext(context.Background(), userID)
+}
+
+// GetUserContext is like GetUser but honors ctx for cancellation and deadlines
+func (c *Client) GetUserContext(ctx context.Context, userID string) (*User, error) {
+	variables := map[string]interface{}{
+		"id": userID,
+	}
+
+	data, err := queryInto[struct {
+		User *User `json:"user"`
+	}](ctx, c, "get_user.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.User == nil {
+		return nil, &NotFoundError{Resource: "user", Ref: userID}
+	}
+
+	return data.User, nil
+}
+
+// GetUsersOptions contains optional parameters for listing users
+type GetUsersOptions struct {
+	First           int    // Number of users to fetch (max 100)
+	After           string // Cursor to start fetching after
+	IncludeDisabled bool   // Whether to include deactivated users
+	Query           string // Only return users whose name, display name or email contains Query
+}
+
+// GetUsers returns a page of users in the Linear workspace
+func (c *Client) GetUsers(opts *GetUsersOptions) (*Page[User], error) {
+	return c.GetUsersContext(context.Background(), opts)
+}
+
+// GetUsersContext is like GetUsers but honors ctx for cancellation and deadlines
+func (c *Client) GetUsersContext(ctx context.Context, opts *GetUsersOptions) (*Page[User], error) {
+	variables := map[string]interface{}{}
+
+	if opts == nil {
+		opts = &GetUsersOptions{}
+	}
+	paginationVariables(variables, opts.First, opts.After)
+
+	if opts.IncludeDisabled {
+		variables["includeDisabled"] = true
+	}
+
+	if opts.Query != "" {
+		match := map[string]interface{}{"containsIgnoreCase": opts.Query}
+		variables["filter"] = map[string]interface{}{
+			"or": []interface{}{
+				map[string]interface{}{"name": match},
+				map[string]interface{}{"displayName": match},
+				map[string]interface{}{"email": match},
+			},
 		}
-	}`
+	}
 
-	resp, err := c.ExecuteGraphQL(query, nil)
+	data, err := queryInto[struct {
+		Users Page[User] `json:"users"`
+	}](ctx, c, "get_users.graphql", variables)
 	if err != nil {
 		return nil, err
 	}
 
-	// Extract the viewer data
-	viewerData, ok := resp.Data["viewer"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid viewer data format")
+	return &data.Users, nil
+}
+
+// GetAllUsers returns up to limit active users in the workspace, following
+// page cursors as needed. A limit of 0 or less returns every user.
+func (c *Client) GetAllUsers(limit int) ([]User, error) {
+	return c.GetAllUsersContext(context.Background(), limit)
+}
+
+// GetAllUsersContext is like GetAllUsers but honors ctx for cancellation and deadlines
+func (c *Client) GetAllUsersContext(ctx context.Context, limit int) ([]User, error) {
+	return CollectPages(limit, func(after string) (*Page[User], error) {
+		return c.GetUsersContext(ctx, &GetUsersOptions{First: maxPageSize, After: after})
+	})
+}
+
+// GetTeamMembersOptions contains optional parameters for listing team members
+type GetTeamMembersOptions struct {
+	First           int    // Number of members to fetch (max 100)
+	After           string // Cursor to start fetching after
+	IncludeDisabled bool   // Whether to include deactivated users
+}
+
+// GetTeamMembers returns a page of the members of a team
+func (c *Client) GetTeamMembers(teamID string, opts *GetTeamMembersOptions) (*Page[User], error) {
+	return c.GetTeamMembersContext(context.Background(), teamID, opts)
+}
+
+// GetTeamMembersContext is like GetTeamMembers but honors ctx for cancellation and deadlines
+func (c *Client) GetTeamMembersContext(ctx context.Context, teamID string, opts *GetTeamMembersOptions) (*Page[User], error) {
+	variables := map[string]interface{}{
+		"id": teamID,
+	}
+
+	if opts == nil {
+		opts = &GetTeamMembersOptions{}
 	}
+	paginationVariables(variables, opts.First, opts.After)
 
-	user := &User{
-		ID:    viewerData["id"].(string),
-		Name:  viewerData["name"].(string),
-		Email: viewerData["email"].(string),
+	if opts.IncludeDisabled {
+		variables["includeDisabled"] = true
+	}
+
+	data, err := queryInto[struct {
+		Team *struct {
+			Members Page[User] `json:"members"`
+		} `json:"team"`
+	}](ctx, c, "get_team_members.graphql", variables)
+	if err != nil {
+		return nil, err
 	}
 
-	return user, nil
+	if data.Team == nil {
+		return nil, &NotFoundError{Resource: "team", Ref: teamID}
+	}
+
+	return &data.Team.Members, nil
+}
+
+// GetAllTeamMembers returns up to limit active members of a team, following
+// page cursors as needed. A limit of 0 or less returns every member.
+func (c *Client) GetAllTeamMembers(teamID string, limit int) ([]User, error) {
+	return c.GetAllTeamMembersContext(context.Background(), teamID, limit)
+}
+
+// GetAllTeamMembersContext is like GetAllTeamMembers but honors ctx for cancellation and deadlines
+func (c *Client) GetAllTeamMembersContext(ctx context.Context, teamID string, limit int) ([]User, error) {
+	return CollectPages(limit, func(after string) (*Page[User], error) {
+		return c.GetTeamMembersContext(ctx, teamID, &GetTeamMembersOptions{First: maxPageSize, After: after})
+	})
 }
@@ -0,0 +1,59 @@
+package linear
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUserDirectory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch {
+		case strings.HasPrefix(req.Query, "query GetUsers"):
+			filter, _ := json.Marshal(req.Variables["filter"])
+			if !strings.Contains(string(filter), `"displayName":{"containsIgnoreCase":"ada"}`) || req.Variables["includeDisabled"] != true {
+				t.Errorf("Expected a name filter including disabled users, got %v", req.Variables)
+			}
+
+			w.Write([]byte(`{"data": {"users": {"nodes": [
+				{"id": "user1", "name": "Ada Lovelace", "displayName": "ada", "email": "ada@example.com", "active": false, "admin": true, "timezone": "Europe/London"}
+			], "pageInfo": {"hasNextPage": false}}}}`))
+		case strings.HasPrefix(req.Query, "query GetTeamMembers"):
+			w.Write([]byte(`{"data": {"team": null}}`))
+		default:
+			t.Errorf("Unexpected query: %s", req.Query)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("test_api_key", WithURL(server.URL))
+
+	users, err := client.GetUsers(&GetUsersOptions{Query: "ada", IncludeDisabled: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(users.Nodes) != 1 || users.Nodes[0].Active || !users.Nodes[0].Admin || users.Nodes[0].Timezone != "Europe/London" {
+		t.Errorf("Expected a deactivated admin in London, got %+v", users.Nodes)
+	}
+
+	if out, err := json.Marshal(users.Nodes[0]); err != nil || !strings.Contains(string(out), `"active":false`) {
+		t.Errorf("Expected a deactivated user to keep its active flag, got %s, %v", out, err)
+	}
+
+	if _, err := client.GetTeamMembers("missing", nil); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected a missing team to be not found, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// WorkflowState represents a Linear workflow state
+type WorkflowState struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type,omitempty"` // triage, backlog, unstarted, started, completed or canceled
+	Color    string  `json:"color,omitempty"`
+	Position float64 `json:"position,omitempty"`
+}
+
+// Workflow state types, in the order issues usually move through them
+const (
+	StateTypeTriage    = "triage"
+	StateTypeBacklog   = "backlog"
+	StateTypeUnstarted = "unstarted"
+	StateTypeStarted   = "started"
+	StateTypeCompleted = "completed"
+	StateTypeCanceled  = "canceled"
+)
+
+// stateTypeOrder ranks state types for sorting
+var stateTypeOrder = map[string]int{
+	StateTypeTriage:    0,
+	StateTypeBacklog:   1,
+	StateTypeUnstarted: 2,
+	StateTypeStarted:   3,
+	StateTypeCompleted: 4,
+	StateTypeCanceled:  5,
+}
+
+// stateTypeAliases maps common ways of describing a state to its type
+var stateTypeAliases = map[string]string{
+	"triage":      StateTypeTriage,
+	"backlog":     StateTypeBacklog,
+	"unstarted":   StateTypeUnstarted,
+	"todo":        StateTypeUnstarted,
+	"to do":       StateTypeUnstarted,
+	"started":     StateTypeStarted,
+	"in progress": StateTypeStarted,
+	"doing":       StateTypeStarted,
+	"completed":   StateTypeCompleted,
+	"complete":    StateTypeCompleted,
+	"done":        StateTypeCompleted,
+	"closed":      StateTypeCompleted,
+	"finished":    StateTypeCompleted,
+	"canceled":    StateTypeCanceled,
+	"cancelled":   StateTypeCanceled,
+	"cancel":      StateTypeCanceled,
+}
+
+// GetTeamWorkflowStates returns a team's workflow states, ordered by type and position
+func (c *Client) GetTeamWorkflowStates(teamID string) ([]WorkflowState, error) {
+	return c.GetTeamWorkflowStatesContext(context.Background(), teamID)
+}
+
+// GetTeamWorkflowStatesContext is like GetTeamWorkflowStates but honors ctx for cancellation and deadlines
+func (c *Client) GetTeamWorkflowStatesContext(ctx context.Context, teamID string) ([]WorkflowState, error) {
+	variables := map[string]interface{}{
+		"teamId": teamID,
+	}
+
+	data, err := queryInto[struct {
+		Team *struct {
+			States Page[WorkflowState] `json:"states"`
+		} `json:"team"`
+	}](ctx, c, "get_team_workflow_states.graphql", variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.Team == nil {
+		return nil, &NotFoundError{Resource: "team", Ref: teamID}
+	}
+
+	states := data.Team.States.Nodes
+	sort.SliceStable(states, func(i, j int) bool {
+		if stateTypeOrder[states[i].Type] != stateTypeOrder[states[j].Type] {
+			return stateTypeOrder[states[i].Type] < stateTypeOrder[states[j].Type]
+		}
+		return states[i].Position < states[j].Position
+	})
+
+	return states, nil
+}
+
+// ResolveWorkflowState finds a team's workflow state by ID, name (e.g. "In Review")
+// or type (e.g. "done", "started"). Type references that match several states
+// return an *AmbiguousError listing them.
+func (c *Client) ResolveWorkflowState(teamID, ref string) (*WorkflowState, error) {
+	return c.ResolveWorkflowStateContext(context.Background(), teamID, ref)
+}
+
+// ResolveWorkflowStateContext is like ResolveWorkflowState but honors ctx for cancellation and deadlines
+func (c *Client) ResolveWorkflowStateContext(ctx context.Context, teamID, ref string) (*WorkflowState, error) {
+	states, err := c.GetTeamWorkflowStatesContext(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchWorkflowState(states, ref)
+}
+
+// matchWorkflowState picks the state referenced by ref from states
+func matchWorkflowState(states []WorkflowState, ref string) (*WorkflowState, error) {
+	normalized := normalizeStateRef(ref)
+
+	for i := range states {
+		if states[i].ID == ref {
+			return &states[i], nil
+		}
+	}
+
+	for i := range states {
+		if normalizeStateRef(states[i].Name) == normalized {
+			return &states[i], nil
+		}
+	}
+
+	if stateType, ok := stateTypeAliases[normalized]; ok {
+		var matches []*WorkflowState
+		for i := range states {
+			if states[i].Type == stateType {
+				matches = append(matches, &states[i])
+			}
+		}
+
+		if len(matches) == 1 {
+			return matches[0], nil
+		}
+
+		if len(matches) > 1 {
+			descriptions := make([]string, 0, len(matches))
+			for _, state := range matches {
+				descriptions = append(descriptions, describeState(state))
+			}
+			return nil, &AmbiguousError{Resource: "state", Ref: ref, Matches: descriptions}
+		}
+	}
+
+	options := make([]string, 0, len(states))
+	for i := range states {
+		options = append(options, describeState(&states[i]))
+	}
+	return nil, &NotFoundError{Resource: "state", Ref: fmt.Sprintf("%q", ref), Options: options}
+}
+
+// normalizeStateRef lowercases a state reference and collapses separators
+func normalizeStateRef(ref string) string {
+	ref = strings.ToLower(ref)
+	ref = strings.NewReplacer("-", " ", "_", " ").Replace(ref)
+	return strings.Join(strings.Fields(ref), " ")
+}
+
+// describeState formats a state for error messages, e.g. "In Review (started)"
+func describeState(state *WorkflowState) string {
+	return fmt.Sprintf("%s (%s)", state.Name, state.Type)
+}
@@ -0,0 +1,63 @@
+package linear
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatchWorkflowState(t *testing.T) {
+	states := []WorkflowState{
+		{ID: "s1", Name: "Backlog", Type: StateTypeBacklog},
+		{ID: "s2", Name: "Todo", Type: StateTypeUnstarted},
+		{ID: "s3", Name: "In Progress", Type: StateTypeStarted},
+		{ID: "s4", Name: "In Review", Type: StateTypeStarted},
+		{ID: "s5", Name: "Done", Type: StateTypeCompleted},
+		{ID: "s6", Name: "Canceled", Type: StateTypeCanceled},
+	}
+
+	tests := []struct {
+		ref    string
+		wantID string
+	}{
+		{ref: "s4", wantID: "s4"},
+		{ref: "in review", wantID: "s4"},
+		{ref: "In-Progress", wantID: "s3"},
+		{ref: "done", wantID: "s5"},
+		{ref: "completed", wantID: "s5"},
+		{ref: "to do", wantID: "s2"},
+		{ref: "cancelled", wantID: "s6"},
+	}
+
+	for _, tt := range tests {
+		state, err := matchWorkflowState(states, tt.ref)
+		if err != nil {
+			t.Errorf("matchWorkflowState(%q): unexpected error: %v", tt.ref, err)
+			continue
+		}
+
+		if state.ID != tt.wantID {
+			t.Errorf("matchWorkflowState(%q) = %s, want %s", tt.ref, state.ID, tt.wantID)
+		}
+	}
+
+	// "started" matches both In Progress and In Review
+	_, err := matchWorkflowState(states, "started")
+	var ambiguous *AmbiguousError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("Expected an *AmbiguousError, got %v", err)
+	}
+
+	if len(ambiguous.Matches) != 2 {
+		t.Errorf("Expected 2 matches, got %v", ambiguous.Matches)
+	}
+
+	_, err = matchWorkflowState(states, "shipped")
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Expected a *NotFoundError, got %v", err)
+	}
+
+	if len(notFound.Options) != len(states) {
+		t.Errorf("Expected every state to be listed as an option, got %v", notFound.Options)
+	}
+}
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"mime"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,8 +24,11 @@ import (
 
 // Get Issue Arguments
 type GetIssueArguments struct {
-	ID              string `json:"id" jsonschema:"required,description=The Linear issue ID to fetch"`
-	IncludeChildren bool   `json:"include_children" jsonschema:"description=Whether to include children (sub-issues) in the response"`
+	ID                 string `json:"id" jsonschema:"required,description=The Linear issue ID, identifier (e.g. 'ENG-123') or URL to fetch"`
+	IncludeChildren    bool   `json:"include_children" jsonschema:"description=Whether to include children (sub-issues) in the response"`
+	IncludeComments    bool   `json:"include_comments" jsonschema:"description=Whether to include comments in the response"`
+	IncludeRelations   bool   `json:"include_relations" jsonschema:"description=Whether to include blocking, blocked-by, duplicate and related issues in the response"`
+	IncludeAttachments bool   `json:"include_attachments" jsonschema:"description=Whether to include attachments, such as linked pull requests, URLs and uploaded files, in the response"`
 }
 
 // Get Issue By Identifier Arguments
@@ -29,38 +38,48 @@ type GetIssueByIdentifierArguments struct {
 
 // Get Team Issues Arguments
 type GetTeamIssuesArguments struct {
-	TeamID string `json:"team_id" jsonschema:"required,description=The Linear team ID to fetch issues for"`
+	TeamID string `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name to fetch issues for"`
 	First  int    `json:"first" jsonschema:"description=Number of issues to fetch (max 100)"`
+	After  string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
 }
 
 // Create Issue Arguments
 type CreateIssueArguments struct {
-	TeamID      string `json:"team_id" jsonschema:"required,description=The Linear team ID to create the issue in"`
-	Title       string `json:"title" jsonschema:"required,description=The title of the issue"`
-	Description string `json:"description" jsonschema:"description=The description of the issue"`
-	Priority    int    `json:"priority" jsonschema:"description=The priority of the issue (1-4)"`
-	StateID     string `json:"state_id" jsonschema:"description=The state ID for the issue"`
-	AssigneeID  string `json:"assignee_id" jsonschema:"description=The user ID to assign the issue to"`
-	ProjectID   string `json:"project_id" jsonschema:"description=The project ID to associate the issue with"`
-	ParentID    string `json:"parent_id" jsonschema:"description=The parent issue ID to create this as a sub-issue of"`
+	TeamID      string   `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name to create the issue in"`
+	Title       string   `json:"title" jsonschema:"required,description=The title of the issue"`
+	Description string   `json:"description" jsonschema:"description=The description of the issue"`
+	Priority    int      `json:"priority" jsonschema:"description=The priority of the issue (1-4)"`
+	StateID     string   `json:"state_id" jsonschema:"description=The state ID for the issue"`
+	State       string   `json:"state" jsonschema:"description=The state name or type for the issue (e.g. 'In Review', 'todo', 'started', 'done'); an alternative to state_id"`
+	AssigneeID  string   `json:"assignee_id" jsonschema:"description=The user to assign the issue to, by ID, email, display name or 'me'"`
+	ProjectID   string   `json:"project_id" jsonschema:"description=The project to associate the issue with, by ID, slug ID, URL or name"`
+	Milestone   string   `json:"milestone" jsonschema:"description=The project milestone to add the issue to, by ID or by name within project_id"`
+	ParentID    string   `json:"parent_id" jsonschema:"description=The parent issue ID or identifier (e.g. 'ENG-123') to create this as a sub-issue of"`
+	LabelIDs    []string `json:"label_ids" jsonschema:"description=The labels to apply to the issue, by ID or name (e.g. 'bug'); names are looked up in the team's labels and then workspace labels"`
+	Cycle       string   `json:"cycle" jsonschema:"description=The cycle to add the issue to, by ID, number, or 'current'/'next'"`
 }
 
 // Update Issue Arguments
 type UpdateIssueArguments struct {
-	IssueID     string  `json:"issue_id" jsonschema:"required,description=The Linear issue ID to update"`
-	Title       *string `json:"title" jsonschema:"description=The new title for the issue"`
-	Description *string `json:"description" jsonschema:"description=The new description for the issue"`
-	Priority    *int    `json:"priority" jsonschema:"description=The new priority for the issue (1-4)"`
-	StateID     *string `json:"state_id" jsonschema:"description=The new state ID for the issue"`
-	AssigneeID  *string `json:"assignee_id" jsonschema:"description=The new assignee user ID"`
-	ProjectID   *string `json:"project_id" jsonschema:"description=The new project ID"`
-	ParentID    *string `json:"parent_id" jsonschema:"description=The new parent issue ID"`
+	IssueID     string   `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to update"`
+	Title       *string  `json:"title" jsonschema:"description=The new title for the issue"`
+	Description *string  `json:"description" jsonschema:"description=The new description for the issue"`
+	Priority    *int     `json:"priority" jsonschema:"description=The new priority for the issue (1-4)"`
+	StateID     *string  `json:"state_id" jsonschema:"description=The new state ID for the issue"`
+	State       *string  `json:"state" jsonschema:"description=The new state name or type for the issue (e.g. 'In Review', 'started', 'done'); an alternative to state_id"`
+	AssigneeID  *string  `json:"assignee_id" jsonschema:"description=The new assignee, by user ID, email, display name or 'me'; pass an empty string to unassign"`
+	ProjectID   *string  `json:"project_id" jsonschema:"description=The new project, by ID, slug ID, URL or name"`
+	Milestone   *string  `json:"milestone" jsonschema:"description=The project milestone to move the issue to, by ID or by name within the issue's project; pass an empty string to remove it from its milestone"`
+	ParentID    *string  `json:"parent_id" jsonschema:"description=The new parent issue ID or identifier (e.g. 'ENG-123')"`
+	LabelIDs    []string `json:"label_ids" jsonschema:"description=Replaces all labels on the issue, by ID or name; pass an empty list to clear them. Use add_issue_label/remove_issue_label to change one label"`
+	Cycle       *string  `json:"cycle" jsonschema:"description=The cycle to move the issue to, by ID, number, or 'current'/'next'; pass an empty string to remove it from its cycle"`
 }
 
 // Get Issue Children Arguments
 type GetIssueChildrenArguments struct {
-	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear parent issue ID to fetch children for"`
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear parent issue ID or identifier (e.g. 'ENG-123') to fetch children for"`
 	First   int    `json:"first" jsonschema:"description=Number of children to fetch (max 100)"`
+	After   string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
 }
 
 // Create Project Arguments
@@ -70,20 +89,20 @@ type CreateProjectArguments struct {
 	Icon        string   `json:"icon" jsonschema:"description=The icon for the project"`
 	Color       string   `json:"color" jsonschema:"description=The color for the project"`
 	State       string   `json:"state" jsonschema:"description=The state of the project (planned, started, paused, completed, canceled)"`
-	TeamIDs     []string `json:"team_ids" jsonschema:"description=The team IDs to associate with the project"`
-	LeadID      string   `json:"lead_id" jsonschema:"description=The user ID of the project lead"`
+	TeamIDs     []string `json:"team_ids" jsonschema:"description=The teams to associate with the project, by ID, key or name"`
+	LeadID      string   `json:"lead_id" jsonschema:"description=The project lead, by user ID, email, display name or 'me'"`
 }
 
 // Update Project Arguments
 type UpdateProjectArguments struct {
-	ProjectID   string   `json:"project_id" jsonschema:"required,description=The Linear project ID to update"`
+	ProjectID   string   `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to update"`
 	Name        string   `json:"name" jsonschema:"description=The new name for the project"`
 	Description string   `json:"description" jsonschema:"description=The new description for the project"`
 	Icon        string   `json:"icon" jsonschema:"description=The new icon for the project"`
 	Color       string   `json:"color" jsonschema:"description=The new color for the project"`
 	State       string   `json:"state" jsonschema:"description=The new state of the project (planned, started, paused, completed, canceled)"`
-	TeamIDs     []string `json:"team_ids" jsonschema:"description=The new team IDs to associate with the project"`
-	LeadID      string   `json:"lead_id" jsonschema:"description=The new user ID of the project lead"`
+	TeamIDs     []string `json:"team_ids" jsonschema:"description=The new teams to associate with the project, by ID, key or name"`
+	LeadID      string   `json:"lead_id" jsonschema:"description=The new project lead, by user ID, email, display name or 'me'"`
 }
 
 // Get Teams Arguments
@@ -91,20 +110,537 @@ type GetTeamsArguments struct{}
 
 // Get Team Projects Arguments
 type GetTeamProjectsArguments struct {
-	TeamID string `json:"team_id" jsonschema:"required,description=The Linear team ID to fetch projects for"`
+	TeamID string `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name to fetch projects for"`
 	First  int    `json:"first" jsonschema:"description=Number of projects to fetch (max 100)"`
+	After  string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
 }
 
 // Get Project Issues Arguments
 type GetProjectIssuesArguments struct {
-	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID to fetch issues for"`
+	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to fetch issues for"`
 	First     int    `json:"first" jsonschema:"description=Number of issues to fetch (max 100)"`
+	After     string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// List Comments Arguments
+type ListCommentsArguments struct {
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to list comments for"`
+	First   int    `json:"first" jsonschema:"description=Number of comments to fetch (max 100)"`
+	After   string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// Add Comment Arguments
+type AddCommentArguments struct {
+	IssueID  string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to comment on"`
+	Body     string `json:"body" jsonschema:"required,description=The comment body in markdown"`
+	ParentID string `json:"parent_id" jsonschema:"description=The comment ID to reply to, to post the comment in an existing thread"`
+}
+
+// List Workflow States Arguments
+type ListWorkflowStatesArguments struct {
+	TeamID string `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name to list workflow states for"`
+}
+
+// List Labels Arguments
+type ListLabelsArguments struct {
+	TeamID string `json:"team_id" jsonschema:"description=The Linear team ID, key (e.g. 'ENG') or name to list labels for; lists workspace labels when omitted"`
+	First  int    `json:"first" jsonschema:"description=Number of labels to fetch (max 100)"`
+	After  string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// Create Label Arguments
+type CreateLabelArguments struct {
+	Name        string `json:"name" jsonschema:"required,description=The name of the label"`
+	Color       string `json:"color" jsonschema:"description=The hex color of the label (e.g. '#eb5757')"`
+	Description string `json:"description" jsonschema:"description=The description of the label"`
+	TeamID      string `json:"team_id" jsonschema:"description=The team ID, key or name to create the label in; creates a workspace label when omitted"`
+	ParentID    string `json:"parent_id" jsonschema:"description=The label group to create the label in, by ID or name"`
+	IsGroup     bool   `json:"is_group" jsonschema:"description=Whether to create a label group that other labels can be nested in"`
+}
+
+// Issue Label Arguments
+type IssueLabelArguments struct {
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123')"`
+	LabelID string `json:"label_id" jsonschema:"required,description=The label, by ID or name (e.g. 'bug'); names are looked up in the issue's team and then workspace labels"`
+}
+
+// List Cycles Arguments
+type ListCyclesArguments struct {
+	TeamID      string `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name to list cycles for"`
+	IncludePast bool   `json:"include_past" jsonschema:"description=Whether to include completed cycles; only current and upcoming cycles are listed otherwise"`
+	First       int    `json:"first" jsonschema:"description=Number of cycles to fetch (max 100)"`
+	After       string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// Get Cycle Arguments
+type GetCycleArguments struct {
+	TeamID string `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name the cycle belongs to"`
+	Cycle  string `json:"cycle" jsonschema:"description=The cycle ID, number, or 'current'/'next'/'previous'; defaults to 'current'"`
+}
+
+// Get Cycle Issues Arguments
+type GetCycleIssuesArguments struct {
+	TeamID string `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name the cycle belongs to"`
+	Cycle  string `json:"cycle" jsonschema:"description=The cycle ID, number, or 'current'/'next'/'previous'; defaults to 'current'"`
+	First  int    `json:"first" jsonschema:"description=Number of issues to fetch (max 100)"`
+	After  string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// Search Issues Arguments
+type SearchIssuesArguments struct {
+	Filter          string   `json:"filter" jsonschema:"description=Compact filter query combined with the other arguments, e.g. 'team:ENG state:started assignee:me label:bug priority:<=2 updated:>-7d \"login crash\"'. Keys: team, state, assignee, creator, label, priority, project, cycle, parent, has, no, created, updated, due, sort; unkeyed words are full-text search terms"`
+	Query           string   `json:"query" jsonschema:"description=Full-text search terms matched against issue titles, descriptions and comments"`
+	TeamID          string   `json:"team_id" jsonschema:"description=Only issues in this team, by ID, key (e.g. 'ENG') or name"`
+	StateTypes      []string `json:"state_types" jsonschema:"description=Only issues in these state types (triage, backlog, unstarted, started, completed, canceled)"`
+	States          []string `json:"states" jsonschema:"description=Only issues in these workflow states, by name (e.g. 'In Review')"`
+	Assignee        string   `json:"assignee" jsonschema:"description=Only issues assigned to this user, by ID, email, display name or 'me'; 'none' for unassigned issues"`
+	Creator         string   `json:"creator" jsonschema:"description=Only issues created by this user, by ID, email, display name or 'me'"`
+	Labels          []string `json:"labels" jsonschema:"description=Only issues carrying all of these labels, by ID or name"`
+	Priorities      []int    `json:"priorities" jsonschema:"description=Only issues with these priorities (0 none, 1 urgent, 2 high, 3 medium, 4 low)"`
+	Project         string   `json:"project" jsonschema:"description=Only issues in this project, by ID, slug ID, URL or name"`
+	Cycle           string   `json:"cycle" jsonschema:"description=Only issues in this cycle, by ID, number, or 'current'/'next'/'previous'"`
+	Parent          string   `json:"parent" jsonschema:"description=Only sub-issues of this issue, by ID or identifier (e.g. 'ENG-123')"`
+	HasParent       *bool    `json:"has_parent" jsonschema:"description=Only sub-issues when true, or only top-level issues when false"`
+	CreatedAfter    string   `json:"created_after" jsonschema:"description=Only issues created on or after this ISO 8601 date or relative duration (e.g. '2024-05-01' or '-P2W')"`
+	CreatedBefore   string   `json:"created_before" jsonschema:"description=Only issues created on or before this ISO 8601 date or relative duration"`
+	UpdatedAfter    string   `json:"updated_after" jsonschema:"description=Only issues updated on or after this ISO 8601 date or relative duration"`
+	UpdatedBefore   string   `json:"updated_before" jsonschema:"description=Only issues updated on or before this ISO 8601 date or relative duration"`
+	DueAfter        string   `json:"due_after" jsonschema:"description=Only issues due on or after this ISO 8601 date or relative duration"`
+	DueBefore       string   `json:"due_before" jsonschema:"description=Only issues due on or before this ISO 8601 date or relative duration"`
+	OrderBy         string   `json:"order_by" jsonschema:"description=Sort order: 'createdAt' or 'updatedAt' (most recent first)"`
+	IncludeArchived bool     `json:"include_archived" jsonschema:"description=Whether to include archived issues"`
+	First           int      `json:"first" jsonschema:"description=Number of issues to fetch (max 100)"`
+	After           string   `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// List Issue Relations Arguments
+type ListIssueRelationsArguments struct {
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to list relations for"`
+}
+
+// Add Issue Relation Arguments
+type AddIssueRelationArguments struct {
+	IssueID        string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-12') the relation starts from"`
+	Type           string `json:"type" jsonschema:"required,enum=blocks,enum=blocked_by,enum=duplicate,enum=related,description=How issue_id relates to related_issue_id: blocks, blocked_by, duplicate (issue_id duplicates related_issue_id) or related"`
+	RelatedIssueID string `json:"related_issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-40') on the other side of the relation"`
+}
+
+// Delete Issue Relation Arguments
+type DeleteIssueRelationArguments struct {
+	RelationID string `json:"relation_id" jsonschema:"required,description=The relation ID, as returned by list_issue_relations"`
+}
+
+// Mark As Duplicate Arguments
+type MarkAsDuplicateArguments struct {
+	IssueID       string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') that is a duplicate"`
+	DuplicateOfID string `json:"duplicate_of_id" jsonschema:"required,description=The Linear issue ID or identifier of the original issue it duplicates"`
+}
+
+// Get Dependency Graph Arguments
+type GetDependencyGraphArguments struct {
+	IssueID          string `json:"issue_id" jsonschema:"description=The Linear issue ID or identifier (e.g. 'ENG-123') to start from; either issue_id or project_id is required"`
+	ProjectID        string `json:"project_id" jsonschema:"description=The Linear project ID, slug ID, URL or name whose issues to start from"`
+	MaxDepth         int    `json:"max_depth" jsonschema:"description=How many relations away from the starting issues to follow (default 3)"`
+	MaxNodes         int    `json:"max_nodes" jsonschema:"description=Maximum number of issues to include in the graph (default 100)"`
+	ExcludeSubIssues bool   `json:"exclude_sub_issues" jsonschema:"description=Whether to only follow blocking relations and not sub-issues"`
+}
+
+// Get Issue Tree Arguments
+type GetIssueTreeArguments struct {
+	IssueID  string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') at the top of the tree"`
+	MaxDepth int    `json:"max_depth" jsonschema:"description=How many levels of sub-issues to load (default 3)"`
+	MaxNodes int    `json:"max_nodes" jsonschema:"description=Maximum number of sub-issues to load in total (default 250)"`
+	Format   string `json:"format" jsonschema:"description=Output format: 'outline' (default) for an indented text outline or 'json' for the full nested structure"`
+}
+
+// Batch Create Issues Arguments
+type BatchCreateIssuesArguments struct {
+	Issues []CreateIssueArguments `json:"issues" jsonschema:"required,description=The issues to create, each with the same fields as create_issue"`
+}
+
+// Batch Update Issues Arguments
+type BatchUpdateIssuesArguments struct {
+	IssueIDs   []string `json:"issue_ids" jsonschema:"required,description=The Linear issue IDs or identifiers (e.g. 'ENG-123') to update"`
+	Priority   *int     `json:"priority" jsonschema:"description=The new priority for every issue (1-4)"`
+	State      *string  `json:"state" jsonschema:"description=The new state name or type for every issue (e.g. 'In Review', 'started', 'done'), resolved within each issue's team"`
+	AssigneeID *string  `json:"assignee_id" jsonschema:"description=The new assignee, by user ID, email, display name or 'me'; pass an empty string to unassign"`
+	ProjectID  *string  `json:"project_id" jsonschema:"description=The new project, by ID, slug ID, URL or name"`
+	ParentID   *string  `json:"parent_id" jsonschema:"description=The new parent issue ID or identifier (e.g. 'ENG-123')"`
+	LabelIDs   []string `json:"label_ids" jsonschema:"description=Replaces all labels on every issue, by ID or name resolved within each issue's team; pass an empty list to clear them"`
+	Cycle      *string  `json:"cycle" jsonschema:"description=The cycle to move every issue to, by ID, number, or 'current'/'next'; pass an empty string to remove them from their cycle"`
+}
+
+// Archive Issue Arguments
+type ArchiveIssueArguments struct {
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to archive"`
+	Confirm bool   `json:"confirm" jsonschema:"required,description=Must be true to confirm the issue should be archived"`
+}
+
+// Unarchive Issue Arguments
+type UnarchiveIssueArguments struct {
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to restore from the archive or trash"`
+}
+
+// Delete Issue Arguments
+type DeleteIssueArguments struct {
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to move to the trash"`
+	Confirm bool   `json:"confirm" jsonschema:"required,description=Must be true to confirm the issue should be deleted"`
+}
+
+// Archive Project Arguments
+type ArchiveProjectArguments struct {
+	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to archive"`
+	Confirm   bool   `json:"confirm" jsonschema:"required,description=Must be true to confirm the project should be archived"`
+}
+
+// Unarchive Project Arguments
+type UnarchiveProjectArguments struct {
+	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID to restore from the archive or trash; archived and deleted projects cannot be looked up by name"`
+}
+
+// Delete Project Arguments
+type DeleteProjectArguments struct {
+	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to move to the trash"`
+	Confirm   bool   `json:"confirm" jsonschema:"required,description=Must be true to confirm the project should be deleted"`
+}
+
+// Attach File Arguments
+type AttachFileArguments struct {
+	IssueID  string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to attach the file to"`
+	FilePath string `json:"file_path" jsonschema:"required,description=Local path of the file to upload, e.g. a log file or screenshot, relative to or inside the upload directory set by LINEAR_UPLOAD_DIR"`
+	Mode     string `json:"mode" jsonschema:"description=How to link the file: 'attachment' (default) adds it to the issue's attachments, 'description' appends it to the description and 'comment' posts it in a new comment. Images are embedded inline in descriptions and comments"`
+	Title    string `json:"title" jsonschema:"description=Title of the attachment (defaults to the file name)"`
+	Comment  string `json:"comment" jsonschema:"description=Text to put above the file in comment mode"`
+}
+
+// Link URL Arguments
+type LinkURLArguments struct {
+	IssueID string `json:"issue_id" jsonschema:"required,description=The Linear issue ID or identifier (e.g. 'ENG-123') to link the URL to"`
+	URL     string `json:"url" jsonschema:"required,description=The URL to link, e.g. a failing CI build, pull request or error report"`
+	Title   string `json:"title" jsonschema:"description=Title of the link; Linear fills it in for services it recognizes, such as GitHub pull requests"`
+}
+
+// Delete Attachment Arguments
+type DeleteAttachmentArguments struct {
+	AttachmentID string `json:"attachment_id" jsonschema:"required,description=The ID of the attachment to remove, as listed by get_issue with include_attachments"`
+}
+
+// Who Am I Arguments
+type WhoAmIArguments struct{}
+
+// List Users Arguments
+type ListUsersArguments struct {
+	Query           string `json:"query" jsonschema:"description=Only list users whose name, display name or email contains this text"`
+	IncludeDisabled bool   `json:"include_disabled" jsonschema:"description=Whether to include deactivated users"`
+	First           int    `json:"first" jsonschema:"description=Number of users to fetch (max 100)"`
+	After           string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// List Team Members Arguments
+type ListTeamMembersArguments struct {
+	TeamID          string `json:"team_id" jsonschema:"required,description=The Linear team ID, key (e.g. 'ENG') or name to list members of"`
+	IncludeDisabled bool   `json:"include_disabled" jsonschema:"description=Whether to include deactivated users"`
+	First           int    `json:"first" jsonschema:"description=Number of members to fetch (max 100)"`
+	After           string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// Get My Issues Arguments
+type GetMyIssuesArguments struct {
+	UpdatedSince     string `json:"updated_since" jsonschema:"description=Only include issues updated after this ISO 8601 date (e.g. '2024-05-01') or duration relative to now (e.g. '-P1D' for the last day)"`
+	IncludeCompleted bool   `json:"include_completed" jsonschema:"description=Whether to include completed and canceled issues"`
+	Limit            int    `json:"limit" jsonschema:"description=Maximum number of issues to fetch for each of assigned, created and subscribed (default 100)"`
+}
+
+// Get Notifications Arguments
+type GetNotificationsArguments struct {
+	IncludeRead    bool   `json:"include_read" jsonschema:"description=Whether to include notifications that were already read"`
+	IncludeSnoozed bool   `json:"include_snoozed" jsonschema:"description=Whether to include notifications snoozed until later"`
+	Limit          int    `json:"limit" jsonschema:"description=Maximum number of notifications to return (default 50)"`
+	Format         string `json:"format" jsonschema:"description=Output format: 'summary' (default) for a text digest or 'json' for the full notifications"`
+}
+
+// Update Notifications Arguments
+type UpdateNotificationsArguments struct {
+	NotificationIDs []string `json:"notification_ids" jsonschema:"required,description=IDs of the notifications to update, as listed by get_notifications"`
+	Action          string   `json:"action" jsonschema:"required,description=What to do with the notifications: 'read', 'unread', 'archive', 'unarchive', 'snooze' or 'unsnooze'"`
+	SnoozeUntil     string   `json:"snooze_until" jsonschema:"description=When a snooze ends, as an ISO 8601 timestamp (e.g. '2024-05-01T09:00:00Z') or a date taken as midnight UTC; required for 'snooze'"`
+}
+
+// List Project Milestones Arguments
+type ListProjectMilestonesArguments struct {
+	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to list milestones for"`
+}
+
+// Create Project Milestone Arguments
+type CreateProjectMilestoneArguments struct {
+	ProjectID   string   `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to add the milestone to"`
+	Name        string   `json:"name" jsonschema:"required,description=The name of the milestone, e.g. 'Beta'"`
+	Description string   `json:"description" jsonschema:"description=The description of the milestone"`
+	TargetDate  string   `json:"target_date" jsonschema:"description=The target date of the milestone (YYYY-MM-DD)"`
+	SortOrder   *float64 `json:"sort_order" jsonschema:"description=Position among the project's milestones; new milestones go last when omitted"`
+}
+
+// Update Project Milestone Arguments
+type UpdateProjectMilestoneArguments struct {
+	MilestoneID string   `json:"milestone_id" jsonschema:"required,description=The milestone to update, by ID or by name within project_id"`
+	ProjectID   string   `json:"project_id" jsonschema:"description=The project the milestone belongs to; required when milestone_id is a name"`
+	Name        *string  `json:"name" jsonschema:"description=The new name for the milestone"`
+	Description *string  `json:"description" jsonschema:"description=The new description for the milestone"`
+	TargetDate  *string  `json:"target_date" jsonschema:"description=The new target date (YYYY-MM-DD); pass an empty string to clear it"`
+	SortOrder   *float64 `json:"sort_order" jsonschema:"description=The new position among the project's milestones"`
+}
+
+// Delete Project Milestone Arguments
+type DeleteProjectMilestoneArguments struct {
+	MilestoneID string `json:"milestone_id" jsonschema:"required,description=The milestone to delete, by ID or by name within project_id"`
+	ProjectID   string `json:"project_id" jsonschema:"description=The project the milestone belongs to; required when milestone_id is a name"`
+	Confirm     bool   `json:"confirm" jsonschema:"required,description=Must be true to confirm the milestone should be deleted"`
+}
+
+// Get Project Updates Arguments
+type GetProjectUpdatesArguments struct {
+	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to fetch updates for"`
+	First     int    `json:"first" jsonschema:"description=Number of updates to fetch (max 100)"`
+	After     string `json:"after" jsonschema:"description=Cursor from a previous response's pageInfo.endCursor to fetch the next page"`
+}
+
+// Post Project Update Arguments
+type PostProjectUpdateArguments struct {
+	ProjectID string `json:"project_id" jsonschema:"required,description=The Linear project ID, slug ID, URL or name to post the update on"`
+	Body      string `json:"body" jsonschema:"description=The Markdown body of the update; drafted from recent issue activity when omitted. Required when confirm is true"`
+	Health    string `json:"health" jsonschema:"description=The project health: 'onTrack', 'atRisk' or 'offTrack'; suggested from overdue issues when omitted. Required when confirm is true"`
+	Since     string `json:"since" jsonschema:"description=Summarize issue activity after this date (YYYY-MM-DD) or RFC 3339 time; defaults to the project's last update, or a week ago"`
+	Confirm   bool   `json:"confirm" jsonschema:"description=Set to true to post the update; otherwise a draft is returned for review and nothing is posted"`
 }
 
 // Download Attachment Arguments
 type DownloadAttachmentArguments struct {
-	URL      string `json:"url" jsonschema:"required,description=URL of the attachment to download (must be from uploads.linear.app)"`
-	FilePath string `json:"file_path" jsonschema:"required,description=Local file path to save the downloaded attachment to"`
+	URL       string `json:"url" jsonschema:"required,description=URL of the attachment to download (must be from uploads.linear.app)"`
+	FilePath  string `json:"file_path" jsonschema:"description=Local file path to save the downloaded attachment to, relative to or inside the download directory; required unless inline is set"`
+	Overwrite bool   `json:"overwrite" jsonschema:"description=Whether to replace file_path if it already exists"`
+	Inline    bool   `json:"inline" jsonschema:"description=Return a small image or text attachment (up to 1 MiB) directly instead of saving it to disk"`
+}
+
+// defaultToolTimeout bounds how long a single tool call may spend talking to Linear
+const defaultToolTimeout = 30 * time.Second
+
+// toolTimeout is the per-call deadline applied to every tool handler
+var toolTimeout = defaultToolTimeout
+
+// withToolTimeout derives a context for a single tool call that is cancelled
+// when the MCP client goes away or the per-call timeout elapses
+func withToolTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, toolTimeout)
+}
+
+// toolError translates a client error into an actionable message for the model.
+// resource and ref describe what the tool was operating on (e.g. "issue", "ENG-123");
+// ref may be empty when the failing reference is not known.
+func toolError(action string, err error, resource, ref string) error {
+	var notFound *linear.NotFoundError
+	var ambiguous *linear.AmbiguousError
+	var apiErr *linear.APIError
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return fmt.Errorf("%s: Linear did not respond within %s; try again or request fewer results: %w", action, toolTimeout, err)
+	case errors.As(err, &notFound):
+		return fmt.Errorf("%s: %w", action, notFound)
+	case errors.As(err, &ambiguous):
+		return fmt.Errorf("%s: %w; use a more specific name or ID", action, ambiguous)
+	case errors.Is(err, linear.ErrNotFound) && ref != "":
+		return fmt.Errorf("%s: %s %s does not exist or is not accessible with this API key: %w", action, resource, ref, err)
+	case errors.Is(err, linear.ErrNotFound) && resource != "":
+		return fmt.Errorf("%s: a referenced %s does not exist or is not accessible with this API key: %w", action, resource, err)
+	case errors.Is(err, linear.ErrUnauthorized):
+		return fmt.Errorf("%s: Linear rejected the API key; check that LINEAR_API_KEY is valid: %w", action, err)
+	case errors.Is(err, linear.ErrForbidden):
+		return fmt.Errorf("%s: the API key does not have permission to do this: %w", action, err)
+	case errors.Is(err, linear.ErrRateLimited):
+		return fmt.Errorf("%s: Linear's rate limit was reached; wait a few minutes before retrying: %w", action, err)
+	case errors.Is(err, linear.ErrValidation) && errors.As(err, &apiErr):
+		return fmt.Errorf("%s: Linear rejected the input (%s): %w", action, apiErr.UserMessage(), err)
+	}
+
+	return fmt.Errorf("%s: %w", action, err)
+}
+
+// parseSnoozeUntil parses the end of a snooze, given as an RFC 3339
+// timestamp or a date taken as midnight UTC
+func parseSnoozeUntil(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, fmt.Errorf("snooze_until is required to snooze")
+	}
+
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		if until, err = time.Parse(time.DateOnly, value); err != nil {
+			return time.Time{}, fmt.Errorf("invalid snooze_until %q: expected an ISO 8601 timestamp or date", value)
+		}
+	}
+
+	if !until.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("snooze_until %s is not in the future", value)
+	}
+	return until, nil
+}
+
+// resolveMilestone resolves a milestone ID, or a milestone name within the
+// referenced project, to a milestone ID
+func resolveMilestone(ctx context.Context, resolver *linear.Resolver, projectRef, milestoneRef string) (string, error) {
+	projectID, err := resolver.ResolveProjectID(ctx, projectRef)
+	if err != nil {
+		return "", toolError("failed to resolve project", err, "project", projectRef)
+	}
+
+	milestoneID, err := resolver.ResolveProjectMilestoneID(ctx, projectID, milestoneRef)
+	if err != nil {
+		return "", toolError("failed to resolve milestone", err, "project milestone", milestoneRef)
+	}
+	return milestoneID, nil
+}
+
+// resolveCreateIssueInput resolves the human-friendly references in args to
+// the IDs CreateIssueInput expects
+func resolveCreateIssueInput(ctx context.Context, resolver *linear.Resolver, args CreateIssueArguments) (linear.CreateIssueInput, error) {
+	if args.State != "" && args.StateID != "" {
+		return linear.CreateIssueInput{}, fmt.Errorf("failed to create issue: pass either state or state_id, not both")
+	}
+
+	teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+	if err != nil {
+		return linear.CreateIssueInput{}, toolError("failed to resolve team", err, "team", args.TeamID)
+	}
+
+	stateID := args.StateID
+	if args.State != "" {
+		stateID, err = resolver.ResolveStateID(ctx, teamID, args.State)
+		if err != nil {
+			return linear.CreateIssueInput{}, toolError("failed to resolve state", err, "state", args.State)
+		}
+	}
+
+	assigneeID, err := resolver.ResolveUserID(ctx, args.AssigneeID)
+	if err != nil {
+		return linear.CreateIssueInput{}, toolError("failed to resolve assignee", err, "user", args.AssigneeID)
+	}
+
+	projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+	if err != nil {
+		return linear.CreateIssueInput{}, toolError("failed to resolve project", err, "project", args.ProjectID)
+	}
+
+	milestoneID, err := resolver.ResolveProjectMilestoneID(ctx, projectID, args.Milestone)
+	if err != nil {
+		return linear.CreateIssueInput{}, toolError("failed to resolve milestone", err, "project milestone", args.Milestone)
+	}
+
+	parentID, err := resolver.ResolveIssueID(ctx, args.ParentID)
+	if err != nil {
+		return linear.CreateIssueInput{}, toolError("failed to resolve parent issue", err, "issue", args.ParentID)
+	}
+
+	labelIDs, err := resolver.ResolveLabelIDs(ctx, teamID, args.LabelIDs)
+	if err != nil {
+		return linear.CreateIssueInput{}, toolError("failed to resolve labels", err, "label", "")
+	}
+
+	return linear.CreateIssueInput{
+		TeamID:      teamID,
+		Title:       args.Title,
+		Description: args.Description,
+		Priority:    args.Priority,
+		StateID:     stateID,
+		AssigneeID:  assigneeID,
+		ProjectID:   projectID,
+		MilestoneID: milestoneID,
+		ParentID:    parentID,
+		LabelIDs:    labelIDs,
+		CycleID:     args.Cycle,
+	}, nil
+}
+
+// batchResultTable renders the outcome of a batch operation as a Markdown
+// table, one row per item in the order they were requested. A non-nil err
+// means the batch was cut short, which is noted below the table.
+func batchResultTable(verb string, refs []string, results []linear.BatchResult, err error) string {
+	var b strings.Builder
+
+	succeeded := 0
+	for _, result := range results {
+		if result.Err == nil {
+			succeeded++
+		}
+	}
+	fmt.Fprintf(&b, "%s %d of %d issues\n\n", verb, succeeded, len(results))
+
+	b.WriteString("| # | Item | Result |\n|---|------|--------|\n")
+	for i, result := range results {
+		status := "ok"
+		if result.Issue != nil {
+			status = "ok: " + result.Issue.Identifier
+		}
+		if result.Err != nil {
+			status = "failed: " + batchErrorMessage(result.Err)
+		}
+
+		fmt.Fprintf(&b, "| %d | %s | %s |\n", i+1, tableCell(refs[i]), tableCell(status))
+	}
+
+	if err != nil {
+		reason := err.Error()
+		if errors.Is(err, context.DeadlineExceeded) {
+			reason = fmt.Sprintf("Linear did not finish within %s", toolTimeout)
+		}
+		fmt.Fprintf(&b, "\nThe batch was cut short (%s). Failed rows may not have been attempted; check them before retrying.\n", reason)
+	}
+
+	return b.String()
+}
+
+// batchErrorMessage returns the message for a failed batch item, preferring
+// the message Linear meant to show users, so that items that failed to
+// resolve read the same as items Linear rejected
+func batchErrorMessage(err error) string {
+	var apiErr *linear.APIError
+	var notFound *linear.NotFoundError
+	var ambiguous *linear.AmbiguousError
+
+	switch {
+	case errors.As(err, &apiErr) && errors.Is(err, linear.ErrOutcomeUnknown):
+		return "outcome unknown, check whether it was applied before retrying: " + apiErr.UserMessage()
+	case errors.As(err, &apiErr):
+		return apiErr.UserMessage()
+	case errors.As(err, &notFound):
+		return notFound.Error()
+	case errors.As(err, &ambiguous):
+		return ambiguous.Error()
+	}
+	return err.Error()
+}
+
+// tableCell escapes s for use in a single Markdown table cell
+func tableCell(s string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(s)
+}
+
+// detectContentType guesses the MIME type of a file from its extension,
+// falling back to sniffing its first bytes
+func detectContentType(file *os.File) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(file.Name())); contentType != "" {
+		return contentType, nil
+	}
+
+	head := make([]byte, 512)
+	n, err := file.Read(head)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(head[:n]), nil
 }
 
 func main() {
@@ -114,21 +650,74 @@ func main() {
 		log.Fatalf("LINEAR_API_KEY environment variable is required")
 	}
 
+	// Allow the per-call timeout to be overridden, e.g. LINEAR_TOOL_TIMEOUT=1m
+	if timeout := os.Getenv("LINEAR_TOOL_TIMEOUT"); timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil || d <= 0 {
+			log.Fatalf("LINEAR_TOOL_TIMEOUT must be a positive duration, got %q", timeout)
+		}
+		toolTimeout = d
+	}
+
+	// Downloads are confined to LINEAR_DOWNLOAD_DIR, the working directory by default
+	downloadDir := os.Getenv("LINEAR_DOWNLOAD_DIR")
+	if downloadDir == "" {
+		downloadDir = "."
+	}
+
+	downloadMaxBytes := int64(defaultDownloadMaxBytes)
+	if maxBytes := os.Getenv("LINEAR_DOWNLOAD_MAX_BYTES"); maxBytes != "" {
+		n, err := strconv.ParseInt(maxBytes, 10, 64)
+		if err != nil || n <= 0 {
+			log.Fatalf("LINEAR_DOWNLOAD_MAX_BYTES must be a positive number of bytes, got %q", maxBytes)
+		}
+		downloadMaxBytes = n
+	}
+
+	downloads, err := newDownloader(downloadDir, downloadMaxBytes, apiKey, &http.Client{Timeout: 30 * time.Second})
+	if err != nil {
+		log.Fatalf("Failed to set up download directory %s: %v", downloadDir, err)
+	}
+
+	// Uploads are confined to LINEAR_UPLOAD_DIR and disabled when it is unset,
+	// since the working directory may hold secrets such as .env files
+	var uploads *uploadRoot
+	if uploadDir := os.Getenv("LINEAR_UPLOAD_DIR"); uploadDir != "" {
+		uploads, err = newUploadRoot(uploadDir)
+		if err != nil {
+			log.Fatalf("Failed to set up upload directory %s: %v", uploadDir, err)
+		}
+	}
+
 	// Create Linear client
 	client := linear.NewClient(apiKey)
 
+	// Resolve team keys, emails, project names and issue identifiers to IDs
+	resolver := linear.NewResolver(client, linear.DefaultResolverTTL)
+
 	// Set up MCP server
 	server := mcp_golang.NewServer(stdio.NewStdioServerTransport())
 
 	// Register getIssue tool
-	err := server.RegisterTool("get_issue", "Get a Linear issue by ID", func(args GetIssueArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("get_issue", "Get a Linear issue by ID, identifier (e.g. 'ENG-123') or URL", func(ctx context.Context, args GetIssueArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
 		opts := &linear.GetIssueOptions{
-			IncludeChildren: args.IncludeChildren,
+			IncludeChildren:    args.IncludeChildren,
+			IncludeComments:    args.IncludeComments,
+			IncludeRelations:   args.IncludeRelations,
+			IncludeAttachments: args.IncludeAttachments,
 		}
 
-		issue, err := client.GetIssue(args.ID, opts)
+		issueID, err := resolver.ResolveIssueID(ctx, args.ID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get issue: %w", err)
+			return nil, toolError("failed to resolve issue", err, "issue", args.ID)
+		}
+
+		issue, err := client.GetIssueContext(ctx, issueID, opts)
+		if err != nil {
+			return nil, toolError("failed to get issue", err, "issue", args.ID)
 		}
 
 		jsonData, err := json.MarshalIndent(issue, "", "  ")
@@ -143,14 +732,23 @@ func main() {
 	}
 
 	// Register getTeamIssues tool
-	err = server.RegisterTool("get_team_issues", "Get issues for a Linear team", func(args GetTeamIssuesArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("get_team_issues", "Get a page of issues for a Linear team. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args GetTeamIssuesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
 		opts := &linear.GetTeamIssuesOptions{
 			First: args.First,
+			After: args.After,
 		}
 
-		issues, err := client.GetTeamIssues(args.TeamID, opts)
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get team issues: %w", err)
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		issues, err := client.GetTeamIssuesContext(ctx, teamID, opts)
+		if err != nil {
+			return nil, toolError("failed to get team issues", err, "team", args.TeamID)
 		}
 
 		jsonData, err := json.MarshalIndent(issues, "", "  ")
@@ -165,21 +763,18 @@ func main() {
 	}
 
 	// Register createIssue tool
-	err = server.RegisterTool("create_issue", "Create a new Linear issue", func(args CreateIssueArguments) (*mcp_golang.ToolResponse, error) {
-		input := linear.CreateIssueInput{
-			TeamID:      args.TeamID,
-			Title:       args.Title,
-			Description: args.Description,
-			Priority:    args.Priority,
-			StateID:     args.StateID,
-			AssigneeID:  args.AssigneeID,
-			ProjectID:   args.ProjectID,
-			ParentID:    args.ParentID,
+	err = server.RegisterTool("create_issue", "Create a new Linear issue", func(ctx context.Context, args CreateIssueArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		input, err := resolveCreateIssueInput(ctx, resolver, args)
+		if err != nil {
+			return nil, err
 		}
 
-		issue, err := client.CreateIssue(input)
+		issue, err := client.CreateIssueContext(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create issue: %w", err)
+			return nil, toolError("failed to create issue", err, "team, state, assignee, project, parent issue or cycle", "")
 		}
 
 		jsonData, err := json.MarshalIndent(issue, "", "  ")
@@ -194,20 +789,93 @@ func main() {
 	}
 
 	// Register updateIssue tool
-	err = server.RegisterTool("update_issue", "Update an existing Linear issue", func(args UpdateIssueArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("update_issue", "Update an existing Linear issue", func(ctx context.Context, args UpdateIssueArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if args.State != nil && args.StateID != nil {
+			return nil, fmt.Errorf("failed to update issue: pass either state or state_id, not both")
+		}
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		stateID := args.StateID
+		if args.State != nil {
+			// States are per-team, so resolve against the issue's team
+			id, err := resolver.ResolveIssueStateID(ctx, args.IssueID, *args.State)
+			if err != nil {
+				return nil, toolError("failed to resolve state", err, "state", *args.State)
+			}
+			stateID = &id
+		}
+
+		assigneeID := args.AssigneeID
+		if assigneeID != nil {
+			id, err := resolver.ResolveUserID(ctx, *assigneeID)
+			if err != nil {
+				return nil, toolError("failed to resolve assignee", err, "user", *assigneeID)
+			}
+			assigneeID = &id
+		}
+
+		projectID := args.ProjectID
+		if projectID != nil {
+			id, err := resolver.ResolveProjectID(ctx, *projectID)
+			if err != nil {
+				return nil, toolError("failed to resolve project", err, "project", *projectID)
+			}
+			projectID = &id
+		}
+
+		milestoneID := args.Milestone
+		if milestoneID != nil {
+			// Names are looked up in the issue's new project when it is moving
+			var id string
+			if projectID != nil {
+				id, err = resolver.ResolveProjectMilestoneID(ctx, *projectID, *milestoneID)
+			} else {
+				id, err = resolver.ResolveIssueProjectMilestoneID(ctx, issueID, *milestoneID)
+			}
+			if err != nil {
+				return nil, toolError("failed to resolve milestone", err, "project milestone", *milestoneID)
+			}
+			milestoneID = &id
+		}
+
+		parentID := args.ParentID
+		if parentID != nil {
+			id, err := resolver.ResolveIssueID(ctx, *parentID)
+			if err != nil {
+				return nil, toolError("failed to resolve parent issue", err, "issue", *parentID)
+			}
+			parentID = &id
+		}
+
+		// Label names are looked up in the issue's team
+		labelIDs, err := resolver.ResolveIssueLabelIDs(ctx, issueID, args.LabelIDs)
+		if err != nil {
+			return nil, toolError("failed to resolve labels", err, "label", "")
+		}
+
 		input := linear.UpdateIssueInput{
 			Title:       args.Title,
 			Description: args.Description,
 			Priority:    args.Priority,
-			StateID:     args.StateID,
-			AssigneeID:  args.AssigneeID,
-			ProjectID:   args.ProjectID,
-			ParentID:    args.ParentID,
+			StateID:     stateID,
+			AssigneeID:  assigneeID,
+			ProjectID:   projectID,
+			MilestoneID: milestoneID,
+			ParentID:    parentID,
+			LabelIDs:    labelIDs,
+			CycleID:     args.Cycle,
 		}
 
-		issue, err := client.UpdateIssue(args.IssueID, input)
+		issue, err := client.UpdateIssueContext(ctx, issueID, input)
 		if err != nil {
-			return nil, fmt.Errorf("failed to update issue: %w", err)
+			return nil, toolError("failed to update issue", err, "issue", args.IssueID)
 		}
 
 		jsonData, err := json.MarshalIndent(issue, "", "  ")
@@ -222,14 +890,23 @@ func main() {
 	}
 
 	// Register getIssueChildren tool
-	err = server.RegisterTool("get_issue_children", "Get sub-issues for a Linear issue", func(args GetIssueChildrenArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("get_issue_children", "Get a page of sub-issues for a Linear issue. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args GetIssueChildrenArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
 		opts := &linear.GetIssueChildrenOptions{
 			First: args.First,
+			After: args.After,
 		}
 
-		children, err := client.GetIssueChildren(args.IssueID, opts)
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get issue children: %w", err)
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		children, err := client.GetIssueChildrenContext(ctx, issueID, opts)
+		if err != nil {
+			return nil, toolError("failed to get issue children", err, "issue", args.IssueID)
 		}
 
 		jsonData, err := json.MarshalIndent(children, "", "  ")
@@ -244,20 +921,33 @@ func main() {
 	}
 
 	// Register createProject tool
-	err = server.RegisterTool("create_project", "Create a new Linear project", func(args CreateProjectArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("create_project", "Create a new Linear project", func(ctx context.Context, args CreateProjectArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teamIDs, err := resolver.ResolveTeamIDs(ctx, args.TeamIDs)
+		if err != nil {
+			return nil, toolError("failed to resolve teams", err, "team", "")
+		}
+
+		leadID, err := resolver.ResolveUserID(ctx, args.LeadID)
+		if err != nil {
+			return nil, toolError("failed to resolve lead", err, "user", args.LeadID)
+		}
+
 		input := linear.CreateProjectInput{
 			Name:        args.Name,
 			Description: args.Description,
 			Icon:        args.Icon,
 			Color:       args.Color,
 			State:       args.State,
-			TeamIDs:     args.TeamIDs,
-			LeadID:      args.LeadID,
+			TeamIDs:     teamIDs,
+			LeadID:      leadID,
 		}
 
-		project, err := client.CreateProject(input)
+		project, err := client.CreateProjectContext(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create project: %w", err)
+			return nil, toolError("failed to create project", err, "team or lead", "")
 		}
 
 		jsonData, err := json.MarshalIndent(project, "", "  ")
@@ -272,10 +962,13 @@ func main() {
 	}
 
 	// Register getTeams tool
-	err = server.RegisterTool("get_teams", "Get all Linear teams", func(args GetTeamsArguments) (*mcp_golang.ToolResponse, error) {
-		teams, err := client.GetTeams()
+	err = server.RegisterTool("get_teams", "Get all Linear teams", func(ctx context.Context, args GetTeamsArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teams, err := client.GetTeamsContext(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get teams: %w", err)
+			return nil, toolError("failed to get teams", err, "", "")
 		}
 
 		jsonData, err := json.MarshalIndent(teams, "", "  ")
@@ -290,7 +983,10 @@ func main() {
 	}
 
 	// Register updateProject tool
-	err = server.RegisterTool("update_project", "Update an existing Linear project", func(args UpdateProjectArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("update_project", "Update an existing Linear project", func(ctx context.Context, args UpdateProjectArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
 		// Convert string values to pointers if provided
 		var name, description, icon, color, state, leadID *string
 
@@ -315,7 +1011,21 @@ func main() {
 		}
 
 		if args.LeadID != "" {
-			leadID = &args.LeadID
+			id, err := resolver.ResolveUserID(ctx, args.LeadID)
+			if err != nil {
+				return nil, toolError("failed to resolve lead", err, "user", args.LeadID)
+			}
+			leadID = &id
+		}
+
+		teamIDs, err := resolver.ResolveTeamIDs(ctx, args.TeamIDs)
+		if err != nil {
+			return nil, toolError("failed to resolve teams", err, "team", "")
+		}
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
 		}
 
 		input := linear.UpdateProjectInput{
@@ -324,13 +1034,13 @@ func main() {
 			Icon:        icon,
 			Color:       color,
 			State:       state,
-			TeamIDs:     args.TeamIDs,
+			TeamIDs:     teamIDs,
 			LeadID:      leadID,
 		}
 
-		project, err := client.UpdateProject(args.ProjectID, input)
+		project, err := client.UpdateProjectContext(ctx, projectID, input)
 		if err != nil {
-			return nil, fmt.Errorf("failed to update project: %w", err)
+			return nil, toolError("failed to update project", err, "project", args.ProjectID)
 		}
 
 		jsonData, err := json.MarshalIndent(project, "", "  ")
@@ -345,63 +1055,46 @@ func main() {
 	}
 
 	// Register downloadAttachment tool
-	err = server.RegisterTool("download_attachment", "Download a Linear attachment file", func(args DownloadAttachmentArguments) (*mcp_golang.ToolResponse, error) {
-		// Validate URL is from uploads.linear.app
-		if !strings.HasPrefix(args.URL, "https://uploads.linear.app/") {
-			return nil, fmt.Errorf("invalid URL: must be from uploads.linear.app domain")
-		}
-
-		// Create HTTP client
-		client := &http.Client{
-			Timeout: 30 * time.Second,
-		}
-
-		// Create request
-		req, err := http.NewRequest("GET", args.URL, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
-		}
-
-		// Add headers
-		req.Header.Add("Content-Type", "application/json")
-		req.Header.Add("Authorization", apiKey)
-
-		// Execute request
-		resp, err := client.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to download attachment: %w", err)
+	err = server.RegisterTool("download_attachment", "Download a Linear attachment file into the download directory, or return a small image or text attachment inline", func(ctx context.Context, args DownloadAttachmentArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if args.Inline {
+			file, err := downloads.fetchInline(ctx, args.URL)
+			if err != nil {
+				return nil, err
+			}
+
+			mediaType, _, _ := mime.ParseMediaType(file.MIMEType)
+			switch {
+			case strings.HasPrefix(mediaType, "image/"):
+				return mcp_golang.NewToolResponse(mcp_golang.NewImageContent(base64.StdEncoding.EncodeToString(file.Data), mediaType)), nil
+			case strings.HasPrefix(mediaType, "text/") || mediaType == "application/json" || mediaType == "application/xml":
+				return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(file.Data))), nil
+			default:
+				return nil, fmt.Errorf("failed to download attachment: %s content cannot be returned inline; pass file_path to save it instead", file.MIMEType)
+			}
 		}
-		defer resp.Body.Close()
 
-		// Check status code
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("failed to download attachment: server returned status %d", resp.StatusCode)
-		}
-
-		// Create output file
-		out, err := os.Create(args.FilePath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create output file: %w", err)
-		}
-		defer out.Close()
-
-		// Copy response body to file
-		_, err = io.Copy(out, resp.Body)
+		file, err := downloads.fetchToFile(ctx, args.URL, args.FilePath, args.Overwrite)
 		if err != nil {
-			return nil, fmt.Errorf("failed to write attachment to file: %w", err)
+			return nil, err
 		}
 
-		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully downloaded attachment to %s", args.FilePath))), nil
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully downloaded attachment to %s (%d bytes, %s)", file.Path, file.Size, file.MIMEType))), nil
 	})
 	if err != nil {
 		log.Fatalf("Failed to register download_attachment tool: %v", err)
 	}
 
 	// Register getIssueByIdentifier tool
-	err = server.RegisterTool("get_issue_by_identifier", "Get a Linear issue by its identifier (e.g., 'ENG-123')", func(args GetIssueByIdentifierArguments) (*mcp_golang.ToolResponse, error) {
-		issue, err := client.GetIssueByIdentifier(args.Identifier)
+	err = server.RegisterTool("get_issue_by_identifier", "Get a Linear issue by its identifier (e.g., 'ENG-123')", func(ctx context.Context, args GetIssueByIdentifierArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issue, err := client.GetIssueByIdentifierContext(ctx, args.Identifier)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get issue by identifier: %w", err)
+			return nil, toolError("failed to get issue by identifier", err, "issue", args.Identifier)
 		}
 
 		jsonData, err := json.MarshalIndent(issue, "", "  ")
@@ -416,14 +1109,23 @@ func main() {
 	}
 	
 	// Register getTeamProjects tool
-	err = server.RegisterTool("get_team_projects", "Get projects for a Linear team", func(args GetTeamProjectsArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("get_team_projects", "Get a page of projects for a Linear team. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args GetTeamProjectsArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
 		opts := &linear.GetTeamProjectsOptions{
 			First: args.First,
+			After: args.After,
+		}
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
 		}
 
-		projects, err := client.GetTeamProjects(args.TeamID, opts)
+		projects, err := client.GetTeamProjectsContext(ctx, teamID, opts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get team projects: %w", err)
+			return nil, toolError("failed to get team projects", err, "team", args.TeamID)
 		}
 
 		jsonData, err := json.MarshalIndent(projects, "", "  ")
@@ -438,14 +1140,23 @@ func main() {
 	}
 	
 	// Register getProjectIssues tool
-	err = server.RegisterTool("get_project_issues", "Get issues for a Linear project", func(args GetProjectIssuesArguments) (*mcp_golang.ToolResponse, error) {
+	err = server.RegisterTool("get_project_issues", "Get a page of issues for a Linear project. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args GetProjectIssuesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
 		opts := &linear.GetProjectIssuesOptions{
 			First: args.First,
+			After: args.After,
+		}
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
 		}
 
-		projectWithIssues, err := client.GetProjectIssues(args.ProjectID, opts)
+		projectWithIssues, err := client.GetProjectIssuesContext(ctx, projectID, opts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get project issues: %w", err)
+			return nil, toolError("failed to get project issues", err, "project", args.ProjectID)
 		}
 
 		jsonData, err := json.MarshalIndent(projectWithIssues, "", "  ")
@@ -459,6 +1170,1445 @@ func main() {
 		log.Fatalf("Failed to register get_project_issues tool: %v", err)
 	}
 
+	// Register listComments tool
+	err = server.RegisterTool("list_comments", "List comments on a Linear issue. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args ListCommentsArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		opts := &linear.GetIssueCommentsOptions{
+			First: args.First,
+			After: args.After,
+		}
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		comments, err := client.GetIssueCommentsContext(ctx, issueID, opts)
+		if err != nil {
+			return nil, toolError("failed to list comments", err, "issue", args.IssueID)
+		}
+
+		jsonData, err := json.MarshalIndent(comments, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal comments to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_comments tool: %v", err)
+	}
+
+	// Register addComment tool
+	err = server.RegisterTool("add_comment", "Add a comment to a Linear issue, optionally as a reply in an existing thread", func(ctx context.Context, args AddCommentArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		input := linear.CreateCommentInput{
+			IssueID:  issueID,
+			Body:     args.Body,
+			ParentID: args.ParentID,
+		}
+
+		comment, err := client.CreateCommentContext(ctx, input)
+		if err != nil {
+			return nil, toolError("failed to add comment", err, "issue or parent comment", "")
+		}
+
+		jsonData, err := json.MarshalIndent(comment, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal comment to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register add_comment tool: %v", err)
+	}
+
+	// Register listWorkflowStates tool
+	err = server.RegisterTool("list_workflow_states", "List the workflow states of a Linear team with their type (triage, backlog, unstarted, started, completed, canceled)", func(ctx context.Context, args ListWorkflowStatesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		states, err := client.GetTeamWorkflowStatesContext(ctx, teamID)
+		if err != nil {
+			return nil, toolError("failed to list workflow states", err, "team", args.TeamID)
+		}
+
+		jsonData, err := json.MarshalIndent(states, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal workflow states to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_workflow_states tool: %v", err)
+	}
+
+	// Register listLabels tool
+	err = server.RegisterTool("list_labels", "List issue labels for a Linear team, or workspace labels when no team is given. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args ListLabelsArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		opts := &linear.GetLabelsOptions{
+			First: args.First,
+			After: args.After,
+		}
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		var labels *linear.Page[linear.Label]
+		if teamID != "" {
+			labels, err = client.GetTeamLabelsContext(ctx, teamID, opts)
+		} else {
+			labels, err = client.GetWorkspaceLabelsContext(ctx, opts)
+		}
+		if err != nil {
+			return nil, toolError("failed to list labels", err, "team", args.TeamID)
+		}
+
+		jsonData, err := json.MarshalIndent(labels, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal labels to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_labels tool: %v", err)
+	}
+
+	// Register createLabel tool
+	err = server.RegisterTool("create_label", "Create a new Linear issue label or label group", func(ctx context.Context, args CreateLabelArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		parentID, err := resolver.ResolveLabelID(ctx, teamID, args.ParentID)
+		if err != nil {
+			return nil, toolError("failed to resolve label group", err, "label", args.ParentID)
+		}
+
+		input := linear.CreateLabelInput{
+			Name:        args.Name,
+			Color:       args.Color,
+			Description: args.Description,
+			TeamID:      teamID,
+			ParentID:    parentID,
+			IsGroup:     args.IsGroup,
+		}
+
+		label, err := client.CreateLabelContext(ctx, input)
+		if err != nil {
+			return nil, toolError("failed to create label", err, "team or label group", "")
+		}
+
+		jsonData, err := json.MarshalIndent(label, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal label to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register create_label tool: %v", err)
+	}
+
+	// Register addIssueLabel tool
+	err = server.RegisterTool("add_issue_label", "Add a label to a Linear issue, keeping its existing labels", func(ctx context.Context, args IssueLabelArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		labelIDs, err := resolver.ResolveIssueLabelIDs(ctx, issueID, []string{args.LabelID})
+		if err != nil {
+			return nil, toolError("failed to resolve label", err, "label", args.LabelID)
+		}
+
+		issue, err := client.AddIssueLabelContext(ctx, issueID, labelIDs[0])
+		if err != nil {
+			return nil, toolError("failed to add label", err, "issue or label", "")
+		}
+
+		jsonData, err := json.MarshalIndent(issue, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register add_issue_label tool: %v", err)
+	}
+
+	// Register removeIssueLabel tool
+	err = server.RegisterTool("remove_issue_label", "Remove a label from a Linear issue, keeping its other labels", func(ctx context.Context, args IssueLabelArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		labelIDs, err := resolver.ResolveIssueLabelIDs(ctx, issueID, []string{args.LabelID})
+		if err != nil {
+			return nil, toolError("failed to resolve label", err, "label", args.LabelID)
+		}
+
+		issue, err := client.RemoveIssueLabelContext(ctx, issueID, labelIDs[0])
+		if err != nil {
+			return nil, toolError("failed to remove label", err, "issue or label", "")
+		}
+
+		jsonData, err := json.MarshalIndent(issue, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register remove_issue_label tool: %v", err)
+	}
+
+	// Register listCycles tool
+	err = server.RegisterTool("list_cycles", "List the cycles (sprints) of a Linear team with their dates, progress and scope. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args ListCyclesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		opts := &linear.GetTeamCyclesOptions{
+			First:       args.First,
+			After:       args.After,
+			IncludePast: args.IncludePast,
+		}
+
+		cycles, err := client.GetTeamCyclesContext(ctx, teamID, opts)
+		if err != nil {
+			return nil, toolError("failed to list cycles", err, "team", args.TeamID)
+		}
+
+		jsonData, err := json.MarshalIndent(cycles, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal cycles to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_cycles tool: %v", err)
+	}
+
+	// Register getCycle tool
+	err = server.RegisterTool("get_cycle", "Get a Linear cycle (sprint) of a team, such as the current or next cycle", func(ctx context.Context, args GetCycleArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		ref := args.Cycle
+		if ref == "" {
+			ref = linear.CycleCurrent
+		}
+
+		cycle, err := client.ResolveCycleContext(ctx, teamID, ref)
+		if err != nil {
+			return nil, toolError("failed to get cycle", err, "cycle", ref)
+		}
+
+		jsonData, err := json.MarshalIndent(cycle, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal cycle to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register get_cycle tool: %v", err)
+	}
+
+	// Register getCycleIssues tool
+	err = server.RegisterTool("get_cycle_issues", "Get a page of issues in a Linear cycle (sprint), such as the current or next cycle. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args GetCycleIssuesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		ref := args.Cycle
+		if ref == "" {
+			ref = linear.CycleCurrent
+		}
+
+		cycle, err := client.ResolveCycleContext(ctx, teamID, ref)
+		if err != nil {
+			return nil, toolError("failed to get cycle", err, "cycle", ref)
+		}
+
+		opts := &linear.GetCycleIssuesOptions{
+			First: args.First,
+			After: args.After,
+		}
+
+		issues, err := client.GetCycleIssuesContext(ctx, cycle.ID, opts)
+		if err != nil {
+			return nil, toolError("failed to get cycle issues", err, "cycle", ref)
+		}
+
+		jsonData, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issues to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register get_cycle_issues tool: %v", err)
+	}
+
+	// Register searchIssues tool
+	err = server.RegisterTool("search_issues", "Search Linear issues by full-text terms and/or structured filters such as state, assignee, labels, priority, project, cycle and dates. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args SearchIssuesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		opts := &linear.SearchIssuesOptions{Filter: &linear.IssueFilter{}}
+		if args.Filter != "" {
+			parsed, err := linear.ParseIssueQuery(args.Filter)
+			if err != nil {
+				return nil, toolError("failed to parse filter", err, "", "")
+			}
+			opts = parsed
+		}
+
+		// Structured arguments narrow whatever the filter query selected
+		filter := opts.Filter
+		filter.StateTypes = append(filter.StateTypes, args.StateTypes...)
+		filter.StateNames = append(filter.StateNames, args.States...)
+		filter.Labels = append(filter.Labels, args.Labels...)
+		filter.Priorities = append(filter.Priorities, args.Priorities...)
+
+		if args.TeamID != "" {
+			filter.TeamIDs = append(filter.TeamIDs, args.TeamID)
+		}
+
+		if strings.EqualFold(args.Assignee, "none") {
+			filter.Unassigned = true
+		} else if args.Assignee != "" {
+			filter.AssigneeIDs = append(filter.AssigneeIDs, args.Assignee)
+		}
+
+		if args.Creator != "" {
+			filter.CreatorIDs = append(filter.CreatorIDs, args.Creator)
+		}
+
+		if args.Project != "" {
+			filter.ProjectIDs = append(filter.ProjectIDs, args.Project)
+		}
+
+		if args.Cycle != "" {
+			filter.Cycle = args.Cycle
+		}
+
+		if args.Parent != "" {
+			filter.ParentID = args.Parent
+		}
+
+		if args.HasParent != nil {
+			filter.HasParent = args.HasParent
+		}
+
+		for _, bound := range []struct{ arg, field *string }{
+			{&args.CreatedAfter, &filter.CreatedAfter},
+			{&args.CreatedBefore, &filter.CreatedBefore},
+			{&args.UpdatedAfter, &filter.UpdatedAfter},
+			{&args.UpdatedBefore, &filter.UpdatedBefore},
+			{&args.DueAfter, &filter.DueAfter},
+			{&args.DueBefore, &filter.DueBefore},
+		} {
+			if *bound.arg != "" {
+				*bound.field = *bound.arg
+			}
+		}
+
+		if err := resolver.ResolveIssueFilter(ctx, filter); err != nil {
+			return nil, toolError("failed to resolve filter", err, "", "")
+		}
+
+		opts.Term = strings.TrimSpace(opts.Term + " " + args.Query)
+		if args.OrderBy != "" {
+			opts.OrderBy = args.OrderBy
+		}
+		opts.IncludeArchived = args.IncludeArchived
+		opts.First = args.First
+		opts.After = args.After
+
+		issues, err := client.SearchIssuesContext(ctx, opts)
+		if err != nil {
+			return nil, toolError("failed to search issues", err, "", "")
+		}
+
+		jsonData, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issues to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register search_issues tool: %v", err)
+	}
+
+	// Register listIssueRelations tool
+	err = server.RegisterTool("list_issue_relations", "List the issues a Linear issue blocks, is blocked by, duplicates, is duplicated by or is related to", func(ctx context.Context, args ListIssueRelationsArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		relations, err := client.GetIssueRelationsContext(ctx, issueID)
+		if err != nil {
+			return nil, toolError("failed to list issue relations", err, "issue", args.IssueID)
+		}
+
+		jsonData, err := json.MarshalIndent(relations, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue relations to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_issue_relations tool: %v", err)
+	}
+
+	// Register addIssueRelation tool
+	err = server.RegisterTool("add_issue_relation", "Record that a Linear issue blocks, is blocked by, duplicates or is related to another issue", func(ctx context.Context, args AddIssueRelationArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		switch args.Type {
+		case linear.RelationBlocks, linear.RelationBlockedBy, linear.RelationDuplicate, linear.RelationRelated:
+		default:
+			return nil, fmt.Errorf("failed to add issue relation: type must be one of blocks, blocked_by, duplicate or related, got %q", args.Type)
+		}
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		relatedIssueID, err := resolver.ResolveIssueID(ctx, args.RelatedIssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve related issue", err, "issue", args.RelatedIssueID)
+		}
+
+		input := linear.CreateIssueRelationInput{
+			IssueID:        issueID,
+			RelatedIssueID: relatedIssueID,
+			Type:           args.Type,
+		}
+
+		relation, err := client.CreateIssueRelationContext(ctx, input)
+		if err != nil {
+			return nil, toolError("failed to add issue relation", err, "issue", "")
+		}
+
+		jsonData, err := json.MarshalIndent(relation, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue relation to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register add_issue_relation tool: %v", err)
+	}
+
+	// Register deleteIssueRelation tool
+	err = server.RegisterTool("delete_issue_relation", "Delete a relation between two Linear issues", func(ctx context.Context, args DeleteIssueRelationArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if err := client.DeleteIssueRelationContext(ctx, args.RelationID); err != nil {
+			return nil, toolError("failed to delete issue relation", err, "relation", args.RelationID)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully deleted issue relation %s", args.RelationID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register delete_issue_relation tool: %v", err)
+	}
+
+	// Register markAsDuplicate tool
+	err = server.RegisterTool("mark_as_duplicate", "Mark a Linear issue as a duplicate of another issue", func(ctx context.Context, args MarkAsDuplicateArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		duplicateOfID, err := resolver.ResolveIssueID(ctx, args.DuplicateOfID)
+		if err != nil {
+			return nil, toolError("failed to resolve original issue", err, "issue", args.DuplicateOfID)
+		}
+
+		relation, err := client.MarkAsDuplicateContext(ctx, issueID, duplicateOfID)
+		if err != nil {
+			return nil, toolError("failed to mark issue as duplicate", err, "issue", "")
+		}
+
+		jsonData, err := json.MarshalIndent(relation, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issue relation to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register mark_as_duplicate tool: %v", err)
+	}
+
+	// Register getDependencyGraph tool
+	err = server.RegisterTool("get_dependency_graph", "Walk the blocking relations and sub-issues around a Linear issue or project. Returns the dependency graph in dependency order, the critical path of open issues, the issues that are ready to pick up right now, and any dependency loops", func(ctx context.Context, args GetDependencyGraphArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if (args.IssueID == "") == (args.ProjectID == "") {
+			return nil, fmt.Errorf("failed to get dependency graph: pass either issue_id or project_id")
+		}
+
+		opts := &linear.DependencyGraphOptions{
+			MaxDepth:         args.MaxDepth,
+			MaxNodes:         args.MaxNodes,
+			ExcludeSubIssues: args.ExcludeSubIssues,
+		}
+
+		var graph *linear.DependencyGraph
+		if args.IssueID != "" {
+			issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+			if err != nil {
+				return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+			}
+
+			graph, err = client.GetIssueDependencyGraphContext(ctx, issueID, opts)
+			if err != nil {
+				return nil, toolError("failed to get dependency graph", err, "issue", args.IssueID)
+			}
+		} else {
+			projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+			if err != nil {
+				return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+			}
+
+			graph, err = client.GetProjectDependencyGraphContext(ctx, projectID, opts)
+			if err != nil {
+				return nil, toolError("failed to get dependency graph", err, "project", args.ProjectID)
+			}
+		}
+
+		jsonData, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal dependency graph to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register get_dependency_graph tool: %v", err)
+	}
+
+	// Register getIssueTree tool
+	err = server.RegisterTool("get_issue_tree", "Get a Linear issue with all of its sub-issues, recursively, with progress and estimate rollups at every level. Returns an indented outline by default", func(ctx context.Context, args GetIssueTreeArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		tree, err := client.GetIssueTreeContext(ctx, issueID, &linear.GetIssueTreeOptions{
+			MaxDepth: args.MaxDepth,
+			MaxNodes: args.MaxNodes,
+		})
+		if err != nil {
+			return nil, toolError("failed to get issue tree", err, "issue", args.IssueID)
+		}
+
+		switch args.Format {
+		case "", "outline":
+			return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(tree.Outline())), nil
+		case "json":
+			jsonData, err := json.MarshalIndent(tree, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal issue tree to JSON: %w", err)
+			}
+
+			return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+		default:
+			return nil, fmt.Errorf("failed to get issue tree: unknown format %q, expected outline or json", args.Format)
+		}
+	})
+	if err != nil {
+		log.Fatalf("Failed to register get_issue_tree tool: %v", err)
+	}
+
+	// Register batchCreateIssues tool
+	err = server.RegisterTool("batch_create_issues", "Create several Linear issues in one go. Returns a table with the outcome of every issue; issues that fail do not stop the others from being created", func(ctx context.Context, args BatchCreateIssuesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if len(args.Issues) == 0 {
+			return nil, fmt.Errorf("failed to create issues: pass at least one issue")
+		}
+
+		refs := make([]string, len(args.Issues))
+		results := make([]linear.BatchResult, len(args.Issues))
+		var inputs []linear.CreateIssueInput
+		var positions []int
+		for i, issueArgs := range args.Issues {
+			refs[i] = issueArgs.Title
+
+			input, err := resolveCreateIssueInput(ctx, resolver, issueArgs)
+			if err != nil {
+				results[i] = linear.BatchResult{Ref: issueArgs.Title, Err: err}
+				continue
+			}
+
+			inputs = append(inputs, input)
+			positions = append(positions, i)
+		}
+
+		// Issues cut off by the tool timeout are reported as failed rows
+		created, err := client.BatchCreateIssuesContext(ctx, inputs)
+		for j, result := range created {
+			results[positions[j]] = result
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(batchResultTable("Created", refs, results, err))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register batch_create_issues tool: %v", err)
+	}
+
+	// Register batchUpdateIssues tool
+	err = server.RegisterTool("batch_update_issues", "Apply the same changes to several Linear issues at once, e.g. move them to a state or cycle. Returns a table with the outcome of every issue; issues that fail do not stop the others from being updated", func(ctx context.Context, args BatchUpdateIssuesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if len(args.IssueIDs) == 0 {
+			return nil, fmt.Errorf("failed to update issues: pass at least one issue in issue_ids")
+		}
+
+		shared := linear.UpdateIssueInput{
+			Priority:   args.Priority,
+			AssigneeID: args.AssigneeID,
+			ProjectID:  args.ProjectID,
+			ParentID:   args.ParentID,
+			LabelIDs:   args.LabelIDs,
+			CycleID:    args.Cycle,
+		}
+
+		if shared.AssigneeID != nil {
+			id, err := resolver.ResolveUserID(ctx, *shared.AssigneeID)
+			if err != nil {
+				return nil, toolError("failed to resolve assignee", err, "user", *shared.AssigneeID)
+			}
+			shared.AssigneeID = &id
+		}
+
+		if shared.ProjectID != nil {
+			id, err := resolver.ResolveProjectID(ctx, *shared.ProjectID)
+			if err != nil {
+				return nil, toolError("failed to resolve project", err, "project", *shared.ProjectID)
+			}
+			shared.ProjectID = &id
+		}
+
+		if shared.ParentID != nil {
+			id, err := resolver.ResolveIssueID(ctx, *shared.ParentID)
+			if err != nil {
+				return nil, toolError("failed to resolve parent issue", err, "issue", *shared.ParentID)
+			}
+			shared.ParentID = &id
+		}
+
+		results := make([]linear.BatchResult, len(args.IssueIDs))
+		var updates []linear.IssueUpdate
+		var positions []int
+		for i, ref := range args.IssueIDs {
+			issueID, err := resolver.ResolveIssueID(ctx, ref)
+			if err != nil {
+				results[i] = linear.BatchResult{Ref: ref, Err: err}
+				continue
+			}
+
+			input := shared
+			if args.State != nil {
+				// States are per-team, so resolve against each issue's team
+				stateID, err := resolver.ResolveIssueStateID(ctx, ref, *args.State)
+				if err != nil {
+					results[i] = linear.BatchResult{Ref: ref, Err: err}
+					continue
+				}
+				input.StateID = &stateID
+			}
+
+			if args.LabelIDs != nil {
+				// Label names are looked up in each issue's team
+				labelIDs, err := resolver.ResolveIssueLabelIDs(ctx, issueID, args.LabelIDs)
+				if err != nil {
+					results[i] = linear.BatchResult{Ref: ref, Err: err}
+					continue
+				}
+				input.LabelIDs = labelIDs
+			}
+
+			updates = append(updates, linear.IssueUpdate{IssueID: issueID, Input: input})
+			positions = append(positions, i)
+		}
+
+		// Issues cut off by the tool timeout are reported as failed rows
+		updated, err := client.BatchUpdateIssuesContext(ctx, updates)
+		for j, result := range updated {
+			results[positions[j]] = result
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(batchResultTable("Updated", args.IssueIDs, results, err))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register batch_update_issues tool: %v", err)
+	}
+
+	// Register archiveIssue tool
+	err = server.RegisterTool("archive_issue", "Archive a Linear issue, hiding it from views and searches. It can be restored with unarchive_issue. Requires confirm to be true", func(ctx context.Context, args ArchiveIssueArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if !args.Confirm {
+			return nil, fmt.Errorf("refusing to archive issue %s: set confirm to true to archive it", args.IssueID)
+		}
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		if err := client.ArchiveIssueContext(ctx, issueID); err != nil {
+			return nil, toolError("failed to archive issue", err, "issue", args.IssueID)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully archived issue %s", args.IssueID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register archive_issue tool: %v", err)
+	}
+
+	// Register unarchiveIssue tool
+	err = server.RegisterTool("unarchive_issue", "Restore a Linear issue that was archived or deleted", func(ctx context.Context, args UnarchiveIssueArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		if err := client.UnarchiveIssueContext(ctx, issueID); err != nil {
+			return nil, toolError("failed to unarchive issue", err, "issue", args.IssueID)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully restored issue %s", args.IssueID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register unarchive_issue tool: %v", err)
+	}
+
+	// Register deleteIssue tool
+	err = server.RegisterTool("delete_issue", "Delete a Linear issue by moving it to the trash, e.g. to clean up an issue created by mistake. Linear deletes trashed issues permanently after a grace period; until then unarchive_issue restores it. Requires confirm to be true", func(ctx context.Context, args DeleteIssueArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if !args.Confirm {
+			return nil, fmt.Errorf("refusing to delete issue %s: set confirm to true to delete it", args.IssueID)
+		}
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		if err := client.DeleteIssueContext(ctx, issueID); err != nil {
+			return nil, toolError("failed to delete issue", err, "issue", args.IssueID)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully deleted issue %s", args.IssueID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register delete_issue tool: %v", err)
+	}
+
+	// Register archiveProject tool
+	err = server.RegisterTool("archive_project", "Archive a Linear project. It can be restored with unarchive_project. Requires confirm to be true", func(ctx context.Context, args ArchiveProjectArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if !args.Confirm {
+			return nil, fmt.Errorf("refusing to archive project %s: set confirm to true to archive it", args.ProjectID)
+		}
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+		}
+
+		if err := client.ArchiveProjectContext(ctx, projectID); err != nil {
+			return nil, toolError("failed to archive project", err, "project", args.ProjectID)
+		}
+
+		// The project list cached for name lookups no longer matches
+		resolver.Invalidate()
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully archived project %s", args.ProjectID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register archive_project tool: %v", err)
+	}
+
+	// Register unarchiveProject tool
+	err = server.RegisterTool("unarchive_project", "Restore a Linear project that was archived or deleted", func(ctx context.Context, args UnarchiveProjectArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+		}
+
+		if err := client.UnarchiveProjectContext(ctx, projectID); err != nil {
+			return nil, toolError("failed to unarchive project", err, "project", args.ProjectID)
+		}
+
+		// The project list cached for name lookups no longer matches
+		resolver.Invalidate()
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully restored project %s", args.ProjectID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register unarchive_project tool: %v", err)
+	}
+
+	// Register deleteProject tool
+	err = server.RegisterTool("delete_project", "Delete a Linear project by moving it to the trash. It can be restored with unarchive_project until Linear deletes it permanently. Requires confirm to be true", func(ctx context.Context, args DeleteProjectArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if !args.Confirm {
+			return nil, fmt.Errorf("refusing to delete project %s: set confirm to true to delete it", args.ProjectID)
+		}
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+		}
+
+		if err := client.DeleteProjectContext(ctx, projectID); err != nil {
+			return nil, toolError("failed to delete project", err, "project", args.ProjectID)
+		}
+
+		// The project list cached for name lookups no longer matches
+		resolver.Invalidate()
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully deleted project %s", args.ProjectID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register delete_project tool: %v", err)
+	}
+
+	// Register attachFile tool
+	err = server.RegisterTool("attach_file", "Upload a local file, such as a log or screenshot, to Linear and link it to an issue as an attachment, in its description or in a new comment", func(ctx context.Context, args AttachFileArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if uploads == nil {
+			return nil, fmt.Errorf("failed to attach file: uploads are disabled; set LINEAR_UPLOAD_DIR to the directory files may be uploaded from")
+		}
+
+		mode := args.Mode
+		if mode == "" {
+			mode = "attachment"
+		}
+		if mode != "attachment" && mode != "description" && mode != "comment" {
+			return nil, fmt.Errorf("failed to attach file: unknown mode %q, expected attachment, description or comment", args.Mode)
+		}
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		file, info, err := uploads.open(args.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %w", err)
+		}
+		defer file.Close()
+
+		contentType, err := detectContentType(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+
+		uploaded, err := client.UploadFileContext(ctx, filepath.Base(args.FilePath), contentType, info.Size(), file)
+		if err != nil {
+			return nil, toolError("failed to upload file", err, "", "")
+		}
+
+		var linked string
+		switch mode {
+		case "attachment":
+			title := args.Title
+			if title == "" {
+				title = uploaded.Filename
+			}
+
+			_, err := client.CreateAttachmentContext(ctx, linear.CreateAttachmentInput{
+				IssueID: issueID,
+				URL:     uploaded.AssetURL,
+				Title:   title,
+			})
+			if err != nil {
+				return nil, toolError("failed to attach file", err, "issue", args.IssueID)
+			}
+			linked = "as an attachment"
+		case "description":
+			issue, err := client.GetIssueContext(ctx, issueID, nil)
+			if err != nil {
+				return nil, toolError("failed to attach file", err, "issue", args.IssueID)
+			}
+
+			description := strings.TrimRight(issue.Description, "\n")
+			if description != "" {
+				description += "\n\n"
+			}
+			description += uploaded.Markdown()
+
+			if _, err := client.UpdateIssueContext(ctx, issueID, linear.UpdateIssueInput{Description: &description}); err != nil {
+				return nil, toolError("failed to attach file", err, "issue", args.IssueID)
+			}
+			linked = "in its description"
+		case "comment":
+			body := uploaded.Markdown()
+			if args.Comment != "" {
+				body = args.Comment + "\n\n" + body
+			}
+
+			if _, err := client.CreateCommentContext(ctx, linear.CreateCommentInput{IssueID: issueID, Body: body}); err != nil {
+				return nil, toolError("failed to attach file", err, "issue", args.IssueID)
+			}
+			linked = "in a new comment"
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully uploaded %s (%d bytes) to %s and linked it to issue %s %s", uploaded.Filename, uploaded.Size, uploaded.AssetURL, args.IssueID, linked))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register attach_file tool: %v", err)
+	}
+
+	// Register linkURL tool
+	err = server.RegisterTool("link_url", "Link a URL to a Linear issue as an attachment, e.g. a failing CI build, pull request or error report. Linking a URL the issue already has updates the existing link", func(ctx context.Context, args LinkURLArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issueID, err := resolver.ResolveIssueID(ctx, args.IssueID)
+		if err != nil {
+			return nil, toolError("failed to resolve issue", err, "issue", args.IssueID)
+		}
+
+		attachment, err := client.LinkURLContext(ctx, issueID, args.URL, args.Title)
+		if err != nil {
+			return nil, toolError("failed to link URL", err, "issue", args.IssueID)
+		}
+
+		jsonData, err := json.MarshalIndent(attachment, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal attachment to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register link_url tool: %v", err)
+	}
+
+	// Register deleteAttachment tool
+	err = server.RegisterTool("delete_attachment", "Remove an attachment, such as a linked URL or uploaded file, from a Linear issue", func(ctx context.Context, args DeleteAttachmentArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if err := client.DeleteAttachmentContext(ctx, args.AttachmentID); err != nil {
+			return nil, toolError("failed to delete attachment", err, "attachment", args.AttachmentID)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully deleted attachment %s", args.AttachmentID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register delete_attachment tool: %v", err)
+	}
+
+	// Register whoami tool
+	err = server.RegisterTool("whoami", "Get the Linear user the server is authenticated as", func(ctx context.Context, args WhoAmIArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		viewer, err := client.GetViewerContext(ctx)
+		if err != nil {
+			return nil, toolError("failed to get authenticated user", err, "", "")
+		}
+
+		jsonData, err := json.MarshalIndent(viewer, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal user to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register whoami tool: %v", err)
+	}
+
+	// Register listUsers tool
+	err = server.RegisterTool("list_users", "List users in the Linear workspace, e.g. to find who to assign an issue to by name. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args ListUsersArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		users, err := client.GetUsersContext(ctx, &linear.GetUsersOptions{
+			First:           args.First,
+			After:           args.After,
+			IncludeDisabled: args.IncludeDisabled,
+			Query:           args.Query,
+		})
+		if err != nil {
+			return nil, toolError("failed to list users", err, "", "")
+		}
+
+		jsonData, err := json.MarshalIndent(users, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal users to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_users tool: %v", err)
+	}
+
+	// Register listTeamMembers tool
+	err = server.RegisterTool("list_team_members", "List the members of a Linear team. Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args ListTeamMembersArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		teamID, err := resolver.ResolveTeamID(ctx, args.TeamID)
+		if err != nil {
+			return nil, toolError("failed to resolve team", err, "team", args.TeamID)
+		}
+
+		members, err := client.GetTeamMembersContext(ctx, teamID, &linear.GetTeamMembersOptions{
+			First:           args.First,
+			After:           args.After,
+			IncludeDisabled: args.IncludeDisabled,
+		})
+		if err != nil {
+			return nil, toolError("failed to list team members", err, "team", args.TeamID)
+		}
+
+		jsonData, err := json.MarshalIndent(members, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal team members to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_team_members tool: %v", err)
+	}
+
+	// Register getMyIssues tool
+	err = server.RegisterTool("get_my_issues", "Get the authenticated user's work: issues they are assigned to, created or subscribed to, grouped by state type with overdue and high-priority issues first. Open issues only unless include_completed is set", func(ctx context.Context, args GetMyIssuesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		issues, err := client.GetMyIssuesContext(ctx, &linear.MyIssuesOptions{
+			UpdatedSince:     args.UpdatedSince,
+			IncludeCompleted: args.IncludeCompleted,
+			Limit:            args.Limit,
+		})
+		if err != nil {
+			return nil, toolError("failed to get my issues", err, "", "")
+		}
+
+		jsonData, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal issues to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register get_my_issues tool: %v", err)
+	}
+
+	// Register getNotifications tool
+	err = server.RegisterTool("get_notifications", "Summarize the authenticated user's Linear inbox: mentions, assignments, status changes and other notifications, newest first. Only unread notifications that are not snoozed are listed unless include_read or include_snoozed is set", func(ctx context.Context, args GetNotificationsArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		inbox, err := client.GetNotificationInboxContext(ctx, &linear.NotificationInboxOptions{
+			Limit:          args.Limit,
+			IncludeRead:    args.IncludeRead,
+			IncludeSnoozed: args.IncludeSnoozed,
+		})
+		if err != nil {
+			return nil, toolError("failed to get notifications", err, "", "")
+		}
+
+		switch args.Format {
+		case "", "summary":
+			return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(inbox.Summary())), nil
+		case "json":
+			jsonData, err := json.MarshalIndent(inbox, "", "  ")
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal notifications to JSON: %w", err)
+			}
+			return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+		default:
+			return nil, fmt.Errorf("failed to get notifications: unknown format %q, expected summary or json", args.Format)
+		}
+	})
+	if err != nil {
+		log.Fatalf("Failed to register get_notifications tool: %v", err)
+	}
+
+	// Register updateNotifications tool
+	err = server.RegisterTool("update_notifications", "Triage Linear notifications: mark them read or unread, archive or unarchive them, or snooze them until a given time", func(ctx context.Context, args UpdateNotificationsArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if len(args.NotificationIDs) == 0 {
+			return nil, fmt.Errorf("failed to update notifications: notification_ids is required")
+		}
+
+		var apply func(id string) error
+		switch args.Action {
+		case "read":
+			apply = func(id string) error {
+				_, err := client.MarkNotificationReadContext(ctx, id)
+				return err
+			}
+		case "unread":
+			apply = func(id string) error {
+				_, err := client.MarkNotificationUnreadContext(ctx, id)
+				return err
+			}
+		case "archive":
+			apply = func(id string) error { return client.ArchiveNotificationContext(ctx, id) }
+		case "unarchive":
+			apply = func(id string) error { return client.UnarchiveNotificationContext(ctx, id) }
+		case "snooze":
+			until, err := parseSnoozeUntil(args.SnoozeUntil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to snooze notifications: %w", err)
+			}
+			apply = func(id string) error {
+				_, err := client.SnoozeNotificationContext(ctx, id, until)
+				return err
+			}
+		case "unsnooze":
+			apply = func(id string) error {
+				_, err := client.SnoozeNotificationContext(ctx, id, time.Time{})
+				return err
+			}
+		default:
+			return nil, fmt.Errorf("failed to update notifications: unknown action %q, expected read, unread, archive, unarchive, snooze or unsnooze", args.Action)
+		}
+
+		var failures []string
+		var lastErr error
+		for _, id := range args.NotificationIDs {
+			if err := apply(id); err != nil {
+				failures = append(failures, fmt.Sprintf("- %s: %v", id, err))
+				lastErr = err
+			}
+		}
+
+		if len(failures) == len(args.NotificationIDs) {
+			return nil, toolError(fmt.Sprintf("failed to %s notifications", args.Action), lastErr, "notification", "")
+		}
+
+		msg := fmt.Sprintf("Successfully applied %s to %d of %d notifications", args.Action, len(args.NotificationIDs)-len(failures), len(args.NotificationIDs))
+		if len(failures) > 0 {
+			msg += "\n\nFailed:\n" + strings.Join(failures, "\n")
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(msg)), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register update_notifications tool: %v", err)
+	}
+
+	// Register listProjectMilestones tool
+	err = server.RegisterTool("list_project_milestones", "List the milestones of a Linear project with their target dates and progress", func(ctx context.Context, args ListProjectMilestonesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+		}
+
+		milestones, err := client.GetAllProjectMilestonesContext(ctx, projectID, 0)
+		if err != nil {
+			return nil, toolError("failed to list project milestones", err, "project", args.ProjectID)
+		}
+
+		jsonData, err := json.MarshalIndent(milestones, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project milestones to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register list_project_milestones tool: %v", err)
+	}
+
+	// Register createProjectMilestone tool
+	err = server.RegisterTool("create_project_milestone", "Add a milestone to a Linear project. Issues are added to it with the milestone argument of create_issue and update_issue", func(ctx context.Context, args CreateProjectMilestoneArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+		}
+
+		milestone, err := client.CreateProjectMilestoneContext(ctx, linear.CreateProjectMilestoneInput{
+			ProjectID:   projectID,
+			Name:        args.Name,
+			Description: args.Description,
+			TargetDate:  args.TargetDate,
+			SortOrder:   args.SortOrder,
+		})
+		if err != nil {
+			return nil, toolError("failed to create project milestone", err, "project", args.ProjectID)
+		}
+
+		// The milestones cached for name lookups no longer match
+		resolver.Invalidate()
+
+		jsonData, err := json.MarshalIndent(milestone, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project milestone to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register create_project_milestone tool: %v", err)
+	}
+
+	// Register updateProjectMilestone tool
+	err = server.RegisterTool("update_project_milestone", "Update a Linear project milestone, e.g. to move its target date", func(ctx context.Context, args UpdateProjectMilestoneArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		milestoneID, err := resolveMilestone(ctx, resolver, args.ProjectID, args.MilestoneID)
+		if err != nil {
+			return nil, err
+		}
+
+		milestone, err := client.UpdateProjectMilestoneContext(ctx, milestoneID, linear.UpdateProjectMilestoneInput{
+			Name:        args.Name,
+			Description: args.Description,
+			TargetDate:  args.TargetDate,
+			SortOrder:   args.SortOrder,
+		})
+		if err != nil {
+			return nil, toolError("failed to update project milestone", err, "project milestone", args.MilestoneID)
+		}
+
+		// The milestones cached for name lookups no longer match
+		resolver.Invalidate()
+
+		jsonData, err := json.MarshalIndent(milestone, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project milestone to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register update_project_milestone tool: %v", err)
+	}
+
+	// Register deleteProjectMilestone tool
+	err = server.RegisterTool("delete_project_milestone", "Delete a Linear project milestone. Its issues stay in the project without a milestone. Requires confirm to be true", func(ctx context.Context, args DeleteProjectMilestoneArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		if !args.Confirm {
+			return nil, fmt.Errorf("refusing to delete milestone %s: set confirm to true to delete it", args.MilestoneID)
+		}
+
+		milestoneID, err := resolveMilestone(ctx, resolver, args.ProjectID, args.MilestoneID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := client.DeleteProjectMilestoneContext(ctx, milestoneID); err != nil {
+			return nil, toolError("failed to delete project milestone", err, "project milestone", args.MilestoneID)
+		}
+
+		// The milestones cached for name lookups no longer match
+		resolver.Invalidate()
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(fmt.Sprintf("Successfully deleted milestone %s", args.MilestoneID))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register delete_project_milestone tool: %v", err)
+	}
+
+	// Register getProjectUpdates tool
+	err = server.RegisterTool("get_project_updates", "Get the status updates posted on a Linear project, newest first, with their health (onTrack, atRisk or offTrack). Pass pageInfo.endCursor as after to fetch the next page", func(ctx context.Context, args GetProjectUpdatesArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+		}
+
+		updates, err := client.GetProjectUpdatesContext(ctx, projectID, &linear.GetProjectUpdatesOptions{
+			First: args.First,
+			After: args.After,
+		})
+		if err != nil {
+			return nil, toolError("failed to get project updates", err, "project", args.ProjectID)
+		}
+
+		jsonData, err := json.MarshalIndent(updates, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project updates to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register get_project_updates tool: %v", err)
+	}
+
+	// Register postProjectUpdate tool
+	err = server.RegisterTool("post_project_update", "Post a status update with a health on a Linear project. Without confirm, drafts the update from the issues completed, in progress, added and overdue since the last update and returns it for review without posting. Show the draft to the user, then call again with confirm true and the final body and health", func(ctx context.Context, args PostProjectUpdateArguments) (*mcp_golang.ToolResponse, error) {
+		ctx, cancel := withToolTimeout(ctx)
+		defer cancel()
+
+		switch args.Health {
+		case "", linear.ProjectHealthOnTrack, linear.ProjectHealthAtRisk, linear.ProjectHealthOffTrack:
+		default:
+			return nil, fmt.Errorf("failed to post project update: unknown health %q, expected onTrack, atRisk or offTrack", args.Health)
+		}
+
+		projectID, err := resolver.ResolveProjectID(ctx, args.ProjectID)
+		if err != nil {
+			return nil, toolError("failed to resolve project", err, "project", args.ProjectID)
+		}
+
+		if !args.Confirm {
+			draft, err := client.DraftProjectUpdateContext(ctx, projectID, &linear.DraftProjectUpdateOptions{Since: args.Since})
+			if err != nil {
+				return nil, toolError("failed to draft project update", err, "project", args.ProjectID)
+			}
+
+			body, health := draft.Body, draft.Health
+			if args.Body != "" {
+				body = args.Body
+			}
+			if args.Health != "" {
+				health = args.Health
+			}
+
+			msg := fmt.Sprintf("Draft update for project %s (not posted)\nHealth: %s\n\n%s", args.ProjectID, health, body)
+			if draft.Truncated {
+				msg += "\nOnly the first issues of the project were read, so the draft may be incomplete.\n"
+			}
+			msg += "\nTo post it, call post_project_update again with confirm set to true and the body and health, edited as needed."
+
+			return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(msg)), nil
+		}
+
+		if strings.TrimSpace(args.Body) == "" || args.Health == "" {
+			return nil, fmt.Errorf("failed to post project update: body and health are required when confirm is true; call without confirm to draft them")
+		}
+
+		update, err := client.CreateProjectUpdateContext(ctx, linear.CreateProjectUpdateInput{
+			ProjectID: projectID,
+			Body:      args.Body,
+			Health:    args.Health,
+		})
+		if err != nil {
+			return nil, toolError("failed to post project update", err, "project", args.ProjectID)
+		}
+
+		jsonData, err := json.MarshalIndent(update, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal project update to JSON: %w", err)
+		}
+
+		return mcp_golang.NewToolResponse(mcp_golang.NewTextContent(string(jsonData))), nil
+	})
+	if err != nil {
+		log.Fatalf("Failed to register post_project_update tool: %v", err)
+	}
+
 	// Start the server
 	log.Println("Starting Linear MCP server...")
 	err = server.Serve()
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// uploadRoot is the directory attach_file may read local files from, so that
+// files elsewhere on disk, such as SSH keys or .env files, cannot be uploaded
+type uploadRoot struct {
+	root string // Absolute upload directory with symlinks resolved
+}
+
+// newUploadRoot creates an uploadRoot for the existing directory root
+func newUploadRoot(root string) (*uploadRoot, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+
+	return &uploadRoot{root: resolved}, nil
+}
+
+// open opens the regular file at path inside the upload root. Relative
+// paths are taken relative to the root, and the path is checked both as
+// written and once symlinks are resolved.
+func (u *uploadRoot) open(path string) (*os.File, os.FileInfo, error) {
+	if path == "" {
+		return nil, nil, fmt.Errorf("file_path is required")
+	}
+
+	full := path
+	if !filepath.IsAbs(full) {
+		full = filepath.Join(u.root, full)
+	}
+	full = filepath.Clean(full)
+
+	if !within(u.root, full) {
+		return nil, nil, fmt.Errorf("file_path must be inside the upload directory %s", u.root)
+	}
+
+	resolved, err := filepath.EvalSymlinks(full)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve file: %w", err)
+	}
+	if !within(u.root, resolved) {
+		return nil, nil, fmt.Errorf("file_path must be inside the upload directory %s", u.root)
+	}
+
+	file, err := os.Open(resolved)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	if !info.Mode().IsRegular() {
+		file.Close()
+		return nil, nil, fmt.Errorf("%s is not a regular file", path)
+	}
+
+	return file, info, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUploadPathStaysInRoot(t *testing.T) {
+	u, err := newUploadRoot(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to create upload root: %v", err)
+	}
+
+	outside := t.TempDir()
+	secret := filepath.Join(outside, "id_rsa")
+	if err := os.WriteFile(secret, []byte("private key"), 0o600); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if err := os.Symlink(outside, filepath.Join(u.root, "escape")); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+	if err := os.Symlink(secret, filepath.Join(u.root, "key")); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(u.root, "logs"), 0o755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+
+	for _, path := range []string{
+		secret,
+		"../" + filepath.Base(outside) + "/id_rsa",
+		"escape/id_rsa",
+		"key",
+		"logs",
+		"",
+	} {
+		if file, _, err := u.open(path); err == nil {
+			file.Close()
+			t.Errorf("Expected %q to be rejected", path)
+		}
+	}
+
+	logFile := filepath.Join(u.root, "logs", "build.log")
+	if err := os.WriteFile(logFile, []byte("build failed\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	for _, path := range []string{"logs/build.log", logFile} {
+		file, info, err := u.open(path)
+		if err != nil {
+			t.Errorf("Expected %s inside the upload directory to be accepted: %v", path, err)
+			continue
+		}
+		file.Close()
+
+		if info.Size() != int64(len("build failed\n")) {
+			t.Errorf("Expected the size of %s, got %d", path, info.Size())
+		}
+	}
+}